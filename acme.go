@@ -0,0 +1,162 @@
+package lingo
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Defaults for an ACMEProvider.
+const (
+	defaultACMETTL      = MinRecordTTL
+	defaultACMETimeout  = 5 * time.Minute
+	defaultACMEInterval = 5 * time.Second
+)
+
+// An ACMEProvider solves ACME DNS-01 challenges for domains hosted on Linode DNS. Its Present and
+// CleanUp methods match the challenge provider interface used by common ACME clients such as lego.
+type ACMEProvider struct {
+	domains Domainer
+
+	// Nameservers are the authoritative servers, as host:port, that must all see a challenge record
+	// before Present returns. They default to Linode's own nameservers.
+	Nameservers []string
+
+	// TTL is the TTL of the challenge record.
+	TTL uint
+
+	// PropagationTimeout is how long to wait for the challenge record to propagate, and
+	// PollingInterval is how often to check on it.
+	PropagationTimeout time.Duration
+	PollingInterval    time.Duration
+}
+
+// NewACMEProvider returns a new ACMEProvider given a valid Domainer.
+func NewACMEProvider(domains Domainer) ACMEProvider {
+	return ACMEProvider{
+		domains:            domains,
+		Nameservers:        defaultNameservers(),
+		TTL:                defaultACMETTL,
+		PropagationTimeout: defaultACMETimeout,
+		PollingInterval:    defaultACMEInterval,
+	}
+}
+
+// ACMEChallengeRecord returns the FQDN and TXT value for the DNS-01 challenge of a domain, given
+// the key authorization from the ACME server.
+func ACMEChallengeRecord(domain, keyAuth string) (string, string) {
+	fqdn := "_acme-challenge." + strings.TrimSuffix(strings.TrimPrefix(domain, "*."), ".")
+	digest := sha256.Sum256([]byte(keyAuth))
+
+	return fqdn, base64.RawURLEncoding.EncodeToString(digest[:])
+}
+
+// Present creates the challenge TXT record for a domain and waits until every nameserver sees it.
+// The token is unused and only accepted to match the usual provider interface.
+func (p ACMEProvider) Present(domain, token, keyAuth string) error {
+	fqdn, value := ACMEChallengeRecord(domain, keyAuth)
+
+	zone, name, err := p.findDomain(fqdn)
+	if err != nil {
+		return err
+	}
+
+	record := NewTXTRecord(name, value).WithTTL(p.TTL)
+	if _, err := p.domains.CreateDomainRecord(zone.ID, record); err != nil {
+		return errors.Wrapf(err, "failed to create challenge record for %s", fqdn)
+	}
+
+	return p.waitForTXT(fqdn, value)
+}
+
+// CleanUp removes the challenge TXT record for a domain. Other challenge records with the same name
+// are left alone, so concurrent challenges for the same name don't interfere with each other.
+func (p ACMEProvider) CleanUp(domain, token, keyAuth string) error {
+	fqdn, value := ACMEChallengeRecord(domain, keyAuth)
+
+	zone, name, err := p.findDomain(fqdn)
+	if err != nil {
+		return err
+	}
+
+	records, err := p.domains.ListDomainRecords(zone.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		if record.Type != TXT || !strings.EqualFold(record.Name, name) || record.Target != value {
+			continue
+		}
+
+		if err := p.domains.DeleteDomainRecord(zone.ID, record.ID); err != nil {
+			return errors.Wrapf(err, "failed to delete challenge record for %s", fqdn)
+		}
+	}
+
+	return nil
+}
+
+// Timeout returns how long to wait for propagation and how often to check. It lets ACME clients
+// that ask providers for their timeouts use the configured values.
+func (p ACMEProvider) Timeout() (time.Duration, time.Duration) {
+	return p.PropagationTimeout, p.PollingInterval
+}
+
+// findDomain returns the master Domain that is the longest suffix of an FQDN, along with the name
+// of the FQDN relative to that Domain.
+func (p ACMEProvider) findDomain(fqdn string) (Domain, string, error) {
+	var best Domain
+
+	domains, err := p.domains.ListDomains()
+	if err != nil {
+		return best, "", err
+	}
+
+	host := strings.ToLower(strings.TrimSuffix(fqdn, "."))
+	for _, domain := range domains {
+		if domain.Type != DomainTypeMaster {
+			continue
+		}
+
+		name := strings.ToLower(strings.TrimSuffix(domain.Domain, "."))
+		if (host == name || strings.HasSuffix(host, "."+name)) && len(name) > len(best.Domain) {
+			best = domain
+		}
+	}
+
+	if best.ID == 0 {
+		return best, "", errors.Errorf("no Linode domain found for %s", fqdn)
+	}
+
+	name := strings.TrimSuffix(host, strings.ToLower(strings.TrimSuffix(best.Domain, ".")))
+	return best, strings.TrimSuffix(name, "."), nil
+}
+
+// waitForTXT polls each nameserver until they all return the value for a TXT record.
+func (p ACMEProvider) waitForTXT(fqdn, value string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), p.PropagationTimeout)
+	defer cancel()
+
+	err := waitForNameservers(ctx, p.Nameservers, p.PollingInterval, func(ctx context.Context, resolver *net.Resolver) bool {
+		records, err := resolver.LookupTXT(ctx, absoluteName(fqdn))
+		if err != nil {
+			return false
+		}
+
+		for _, record := range records {
+			if record == value {
+				return true
+			}
+		}
+
+		return false
+	})
+
+	return errors.Wrapf(err, "%s didn't propagate", fqdn)
+}
@@ -0,0 +1,200 @@
+package lingo_test
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"net"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/eriktate/lingo"
+)
+
+// dnsStandIn is a minimal UDP DNS server. It answers every question with the rdata returned by its
+// answer func, or NXDOMAIN if there is none.
+type dnsStandIn struct {
+	conn    net.PacketConn
+	answer  func(name string, qtype uint16) [][]byte
+	queries int32
+}
+
+func newDNSStandIn(t *testing.T, answer func(name string, qtype uint16) [][]byte) *dnsStandIn {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to start DNS stand-in: %s", err)
+	}
+
+	s := &dnsStandIn{conn: conn, answer: answer}
+	go s.serve()
+	t.Cleanup(func() { conn.Close() })
+
+	return s
+}
+
+func (s *dnsStandIn) addr() string {
+	return s.conn.LocalAddr().String()
+}
+
+func (s *dnsStandIn) serve() {
+	buf := make([]byte, 1500)
+	for {
+		n, addr, err := s.conn.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+
+		if res := s.respond(buf[:n]); res != nil {
+			s.conn.WriteTo(res, addr)
+		}
+	}
+}
+
+// respond builds the response to a single query.
+func (s *dnsStandIn) respond(query []byte) []byte {
+	if len(query) < 12 {
+		return nil
+	}
+
+	// Walk the labels of the question name.
+	var labels []string
+	offset := 12
+	for offset < len(query) && query[offset] != 0 {
+		length := int(query[offset])
+		if offset+1+length > len(query) {
+			return nil
+		}
+
+		labels = append(labels, string(query[offset+1:offset+1+length]))
+		offset += 1 + length
+	}
+
+	end := offset + 5
+	if end > len(query) {
+		return nil
+	}
+
+	atomic.AddInt32(&s.queries, 1)
+
+	name := strings.ToLower(strings.Join(labels, ".")) + "."
+	qtype := binary.BigEndian.Uint16(query[offset+1:])
+	answers := s.answer(name, qtype)
+
+	res := make([]byte, 12, 512)
+	copy(res, query[:2])
+	flags := uint16(0x8400) | binary.BigEndian.Uint16(query[2:])&0x0100
+	if len(answers) == 0 {
+		flags |= 3
+	}
+
+	binary.BigEndian.PutUint16(res[2:], flags)
+	binary.BigEndian.PutUint16(res[4:], 1)
+	binary.BigEndian.PutUint16(res[6:], uint16(len(answers)))
+	res = append(res, query[12:end]...)
+
+	for _, rdata := range answers {
+		rr := make([]byte, 12)
+		binary.BigEndian.PutUint16(rr, 0xc00c)
+		binary.BigEndian.PutUint16(rr[2:], qtype)
+		binary.BigEndian.PutUint16(rr[4:], 1)
+		binary.BigEndian.PutUint32(rr[6:], 30)
+		binary.BigEndian.PutUint16(rr[10:], uint16(len(rdata)))
+		res = append(append(res, rr...), rdata...)
+	}
+
+	return res
+}
+
+// txtRData encodes a TXT value as a single character-string.
+func txtRData(value string) []byte {
+	return append([]byte{byte(len(value))}, value...)
+}
+
+func testACMEDomains() *fakeDomains {
+	return &fakeDomains{
+		domains: []lingo.Domain{
+			{ID: 1, Domain: "example.com", Type: lingo.DomainTypeMaster},
+			{ID: 2, Domain: "sub.example.com", Type: lingo.DomainTypeMaster},
+			{ID: 3, Domain: "www.sub.example.com", Type: lingo.DomainTypeSlave},
+		},
+	}
+}
+
+func Test_ACMEPresentAndCleanUp(t *testing.T) {
+	domains := testACMEDomains()
+
+	// Only start answering after a few queries, so Present has to wait for propagation.
+	var asked int32
+	standIn := newDNSStandIn(t, func(name string, qtype uint16) [][]byte {
+		if qtype != 16 || atomic.AddInt32(&asked, 1) < 3 {
+			return nil
+		}
+
+		records, _ := domains.ListDomainRecords(2)
+		var answers [][]byte
+		for _, record := range records {
+			if record.Type == lingo.TXT && strings.ToLower(record.Name)+".sub.example.com." == name {
+				answers = append(answers, txtRData(record.Target))
+			}
+		}
+
+		return answers
+	})
+
+	provider := lingo.NewACMEProvider(domains)
+	provider.Nameservers = []string{standIn.addr()}
+	provider.PropagationTimeout = 5 * time.Second
+	provider.PollingInterval = 10 * time.Millisecond
+
+	digest := sha256.Sum256([]byte("token.thumbprint"))
+	expected := base64.RawURLEncoding.EncodeToString(digest[:])
+
+	if err := provider.Present("*.www.sub.example.com", "token", "token.thumbprint"); err != nil {
+		t.Fatalf("Failed to present challenge: %s", err)
+	}
+
+	records, _ := domains.ListDomainRecords(2)
+	if len(records) != 1 {
+		t.Fatalf("Expected 1 challenge record, but got %d", len(records))
+	}
+
+	record := records[0]
+	if record.Name != "_acme-challenge.www" || record.Target != expected || record.TTLSec != lingo.MinRecordTTL {
+		t.Fatalf("Challenge record wasn't created in the longest matching domain: %+v", record)
+	}
+
+	if atomic.LoadInt32(&standIn.queries) < 3 {
+		t.Fatal("Expected Present to keep polling until the record was visible")
+	}
+
+	if err := provider.CleanUp("*.www.sub.example.com", "token", "token.thumbprint"); err != nil {
+		t.Fatalf("Failed to clean up challenge: %s", err)
+	}
+
+	if records, _ := domains.ListDomainRecords(2); len(records) != 0 {
+		t.Fatal("Expected the challenge record to be deleted")
+	}
+}
+
+func Test_ACMEPropagationTimeout(t *testing.T) {
+	standIn := newDNSStandIn(t, func(string, uint16) [][]byte { return nil })
+
+	provider := lingo.NewACMEProvider(testACMEDomains())
+	provider.Nameservers = []string{standIn.addr()}
+	provider.PropagationTimeout = 100 * time.Millisecond
+	provider.PollingInterval = 10 * time.Millisecond
+
+	if err := provider.Present("example.com", "token", "keyauth"); err == nil {
+		t.Fatal("Expected Present to time out when the record never propagates")
+	}
+}
+
+func Test_ACMEUnknownDomain(t *testing.T) {
+	provider := lingo.NewACMEProvider(testACMEDomains())
+
+	if err := provider.Present("example.org", "token", "keyauth"); err == nil {
+		t.Fatal("Expected Present to fail for a domain that isn't on Linode")
+	}
+}
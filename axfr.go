@@ -0,0 +1,433 @@
+package lingo
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DNS wire format type codes for the record types lingo understands.
+const (
+	dnsTypeA     = 1
+	dnsTypeNS    = 2
+	dnsTypeCNAME = 5
+	dnsTypeSOA   = 6
+	dnsTypePTR   = 12
+	dnsTypeMX    = 15
+	dnsTypeTXT   = 16
+	dnsTypeAAAA  = 28
+	dnsTypeSRV   = 33
+	dnsTypeAXFR  = 252
+	dnsTypeCAA   = 257
+	dnsClassIN   = 1
+)
+
+// The longest an entire zone transfer is allowed to take.
+const axfrTimeout = 2 * time.Minute
+
+// A dnsRR is a single resource record read off the wire, with names already decoded into
+// presentation format.
+type dnsRR struct {
+	name  string
+	rtype uint16
+	class uint16
+	ttl   uint32
+	rdata []byte
+	msg   []byte
+	rdoff int
+}
+
+// TransferZone performs an AXFR of a domain from a nameserver, given as host:port, and converts the
+// result into a Zone ready for DomainClient.ImportZone. Record types Linode can't serve, such as
+// DNSSEC records, are dropped, as are the apex NS records since Linode serves the zone itself.
+func TransferZone(nameserver, domain string) (Zone, error) {
+	var zone Zone
+
+	conn, err := net.DialTimeout("tcp", nameserver, 10*time.Second)
+	if err != nil {
+		return zone, errors.Wrapf(err, "failed to connect to %s", nameserver)
+	}
+	defer conn.Close()
+
+	if err := conn.SetDeadline(time.Now().Add(axfrTimeout)); err != nil {
+		return zone, err
+	}
+
+	var id [2]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return zone, err
+	}
+
+	query, err := buildDNSQuery(binary.BigEndian.Uint16(id[:]), domain, dnsTypeAXFR)
+	if err != nil {
+		return zone, err
+	}
+
+	if _, err := conn.Write(query); err != nil {
+		return zone, errors.Wrap(err, "failed to send AXFR query")
+	}
+
+	var rrs []dnsRR
+	soas := 0
+	for soas < 2 {
+		msg, err := readDNSMessage(conn)
+		if err != nil {
+			return zone, errors.Wrap(err, "failed to read AXFR response")
+		}
+
+		answers, err := parseDNSResponse(msg, binary.BigEndian.Uint16(id[:]))
+		if err != nil {
+			return zone, err
+		}
+
+		if len(rrs) == 0 && (len(answers) == 0 || answers[0].rtype != dnsTypeSOA) {
+			return zone, errors.New("AXFR response didn't start with an SOA record")
+		}
+
+		for _, rr := range answers {
+			if rr.rtype == dnsTypeSOA {
+				soas++
+				if soas == 2 {
+					break
+				}
+			}
+
+			rrs = append(rrs, rr)
+		}
+	}
+
+	return zoneFromRRs(rrs)
+}
+
+// buildDNSQuery builds a TCP framed query for a single question.
+func buildDNSQuery(id uint16, name string, qtype uint16) ([]byte, error) {
+	msg := make([]byte, 12, 512)
+	binary.BigEndian.PutUint16(msg, id)
+	binary.BigEndian.PutUint16(msg[4:], 1)
+
+	for _, label := range strings.Split(strings.TrimSuffix(name, "."), ".") {
+		if label == "" || len(label) > 63 {
+			return nil, errors.Errorf("%q isn't a valid domain name", name)
+		}
+
+		msg = append(msg, byte(len(label)))
+		msg = append(msg, label...)
+	}
+
+	msg = append(msg, 0, 0, 0, 0, 0)
+	binary.BigEndian.PutUint16(msg[len(msg)-4:], qtype)
+	binary.BigEndian.PutUint16(msg[len(msg)-2:], dnsClassIN)
+
+	framed := make([]byte, 2, len(msg)+2)
+	binary.BigEndian.PutUint16(framed, uint16(len(msg)))
+	return append(framed, msg...), nil
+}
+
+// readDNSMessage reads a single length prefixed message from a TCP stream.
+func readDNSMessage(r io.Reader) ([]byte, error) {
+	var length [2]byte
+	if _, err := io.ReadFull(r, length[:]); err != nil {
+		return nil, err
+	}
+
+	msg := make([]byte, binary.BigEndian.Uint16(length[:]))
+	if _, err := io.ReadFull(r, msg); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// parseDNSResponse checks a response's header and returns the records in its answer section.
+func parseDNSResponse(msg []byte, id uint16) ([]dnsRR, error) {
+	if len(msg) < 12 {
+		return nil, errors.New("DNS response is too short")
+	}
+
+	if binary.BigEndian.Uint16(msg) != id {
+		return nil, errors.New("DNS response ID doesn't match the query")
+	}
+
+	if rcode := binary.BigEndian.Uint16(msg[2:]) & 0xf; rcode != 0 {
+		return nil, errors.Errorf("nameserver refused the transfer with rcode %d", rcode)
+	}
+
+	qdcount := int(binary.BigEndian.Uint16(msg[4:]))
+	ancount := int(binary.BigEndian.Uint16(msg[6:]))
+
+	offset := 12
+	for i := 0; i < qdcount; i++ {
+		_, next, err := readDNSName(msg, offset)
+		if err != nil {
+			return nil, err
+		}
+
+		offset = next + 4
+	}
+
+	rrs := make([]dnsRR, 0, ancount)
+	for i := 0; i < ancount; i++ {
+		name, next, err := readDNSName(msg, offset)
+		if err != nil {
+			return nil, err
+		}
+
+		if next+10 > len(msg) {
+			return nil, errors.New("DNS record header is truncated")
+		}
+
+		rr := dnsRR{
+			name:  name,
+			rtype: binary.BigEndian.Uint16(msg[next:]),
+			class: binary.BigEndian.Uint16(msg[next+2:]),
+			ttl:   binary.BigEndian.Uint32(msg[next+4:]),
+			msg:   msg,
+			rdoff: next + 10,
+		}
+
+		rdlen := int(binary.BigEndian.Uint16(msg[next+8:]))
+		if rr.rdoff+rdlen > len(msg) {
+			return nil, errors.New("DNS record data is truncated")
+		}
+
+		rr.rdata = msg[rr.rdoff : rr.rdoff+rdlen]
+		rrs = append(rrs, rr)
+		offset = rr.rdoff + rdlen
+	}
+
+	return rrs, nil
+}
+
+// readDNSName decodes a possibly compressed name starting at offset, returning it with a trailing
+// dot and the offset just past it. Dots inside labels are escaped so the name can be split safely.
+func readDNSName(msg []byte, offset int) (string, int, error) {
+	var labels []string
+	end := -1
+
+	for jumps := 0; ; {
+		if offset >= len(msg) {
+			return "", 0, errors.New("DNS name is truncated")
+		}
+
+		length := int(msg[offset])
+		switch {
+		case length == 0:
+			if end < 0 {
+				end = offset + 1
+			}
+
+			return strings.Join(labels, ".") + ".", end, nil
+		case length&0xc0 == 0xc0:
+			if offset+1 >= len(msg) {
+				return "", 0, errors.New("DNS name pointer is truncated")
+			}
+
+			if jumps++; jumps > 64 {
+				return "", 0, errors.New("DNS name has a compression loop")
+			}
+
+			if end < 0 {
+				end = offset + 2
+			}
+
+			offset = int(binary.BigEndian.Uint16(msg[offset:]) & 0x3fff)
+		default:
+			if offset+1+length > len(msg) {
+				return "", 0, errors.New("DNS label is truncated")
+			}
+
+			label := string(msg[offset+1 : offset+1+length])
+			labels = append(labels, strings.Replace(label, ".", `\.`, -1))
+			offset += 1 + length
+		}
+	}
+}
+
+// rdataName reads a name from the record's rdata, following compression pointers into the message.
+func (rr dnsRR) rdataName(offset int) (string, int, error) {
+	name, next, err := readDNSName(rr.msg, rr.rdoff+offset)
+	return name, next - rr.rdoff, err
+}
+
+// zoneFromRRs converts the records of a zone transfer into a Zone. The first record must be the
+// zone's SOA.
+func zoneFromRRs(rrs []dnsRR) (Zone, error) {
+	var zone Zone
+	p := zoneParser{origin: rrs[0].name}
+
+	for _, rr := range rrs {
+		if rr.class != dnsClassIN {
+			continue
+		}
+
+		if rr.rtype == dnsTypeSOA {
+			if err := p.soaFromRR(rr); err != nil {
+				return zone, err
+			}
+
+			continue
+		}
+
+		record, ok, err := recordFromRR(rr)
+		if err != nil {
+			return zone, errors.Wrapf(err, "failed to decode record for %s", rr.name)
+		}
+
+		if !ok {
+			continue
+		}
+
+		owner, err := p.relative(rr.name)
+		if err != nil {
+			return zone, err
+		}
+
+		if record.Type == NS && owner == "" {
+			continue
+		}
+
+		if record.Type == SRV {
+			labels := strings.SplitN(owner, ".", 3)
+			if len(labels) < 2 || !strings.HasPrefix(labels[0], "_") || !strings.HasPrefix(labels[1], "_") {
+				return zone, errors.Errorf("SRV owner %q isn't of the form _service._protocol", rr.name)
+			}
+
+			record.Service = labels[0][1:]
+			record.Protocol = labels[1][1:]
+			owner = ""
+			if len(labels) == 3 {
+				owner = labels[2]
+			}
+		}
+
+		record.Name = owner
+		if uint(rr.ttl) != p.zone.Domain.TTLSec {
+			record.TTLSec = uint(rr.ttl)
+		}
+
+		p.zone.Records = append(p.zone.Records, record)
+	}
+
+	return p.zone, nil
+}
+
+// soaFromRR fills in the Domain from a zone's SOA record.
+func (p *zoneParser) soaFromRR(rr dnsRR) error {
+	_, next, err := rr.rdataName(0)
+	if err != nil {
+		return err
+	}
+
+	rname, next, err := rr.rdataName(next)
+	if err != nil {
+		return err
+	}
+
+	if next+20 > len(rr.rdata) {
+		return errors.New("SOA record is truncated")
+	}
+
+	timers := rr.rdata[next+4:]
+	p.zone.Domain = Domain{
+		Domain:     strings.TrimSuffix(rr.name, "."),
+		Type:       DomainTypeMaster,
+		SOA:        zoneEmail(rname),
+		RefreshSec: uint(binary.BigEndian.Uint32(timers)),
+		RetrySec:   uint(binary.BigEndian.Uint32(timers[4:])),
+		ExpireSec:  uint(binary.BigEndian.Uint32(timers[8:])),
+		TTLSec:     uint(binary.BigEndian.Uint32(timers[12:])),
+	}
+
+	return nil
+}
+
+// recordFromRR decodes the rdata of a single record. Types Linode doesn't support are reported as
+// not ok rather than as an error.
+func recordFromRR(rr dnsRR) (DomainRecord, bool, error) {
+	var record DomainRecord
+
+	host := func(offset int) (string, error) {
+		name, _, err := rr.rdataName(offset)
+		if name == "." {
+			return name, err
+		}
+		return strings.TrimSuffix(name, "."), err
+	}
+
+	var err error
+	switch rr.rtype {
+	case dnsTypeA, dnsTypeAAAA:
+		if len(rr.rdata) != 4 && len(rr.rdata) != 16 {
+			return record, false, errors.New("address has the wrong length")
+		}
+
+		record.Type = A
+		if rr.rtype == dnsTypeAAAA {
+			record.Type = AAAA
+		}
+		record.Target = net.IP(rr.rdata).String()
+	case dnsTypeNS, dnsTypeCNAME, dnsTypePTR:
+		record.Type = map[uint16]DomainRecordType{dnsTypeNS: NS, dnsTypeCNAME: CNAME, dnsTypePTR: PTR}[rr.rtype]
+		record.Target, err = host(0)
+	case dnsTypeMX:
+		if len(rr.rdata) < 3 {
+			return record, false, errors.New("MX record is truncated")
+		}
+
+		preference := binary.BigEndian.Uint16(rr.rdata)
+		if preference > 255 {
+			return record, false, errors.Errorf("MX preference %d is larger than Linode allows", preference)
+		}
+
+		record.Type = MX
+		record.Priority = uint8(preference)
+		record.Target, err = host(2)
+	case dnsTypeTXT:
+		var text strings.Builder
+		for i := 0; i < len(rr.rdata); {
+			length := int(rr.rdata[i])
+			if i+1+length > len(rr.rdata) {
+				return record, false, errors.New("TXT record is truncated")
+			}
+
+			text.Write(rr.rdata[i+1 : i+1+length])
+			i += 1 + length
+		}
+
+		record.Type = TXT
+		record.Target = text.String()
+	case dnsTypeSRV:
+		if len(rr.rdata) < 7 {
+			return record, false, errors.New("SRV record is truncated")
+		}
+
+		priority := binary.BigEndian.Uint16(rr.rdata)
+		if priority > 255 {
+			return record, false, errors.Errorf("SRV priority %d is larger than Linode allows", priority)
+		}
+
+		record.Type = SRV
+		record.Priority = uint8(priority)
+		record.Weight = uint(binary.BigEndian.Uint16(rr.rdata[2:]))
+		record.Port = uint(binary.BigEndian.Uint16(rr.rdata[4:]))
+		record.Target, err = host(6)
+	case dnsTypeCAA:
+		if len(rr.rdata) < 2 || 2+int(rr.rdata[1]) > len(rr.rdata) {
+			return record, false, errors.New("CAA record is truncated")
+		}
+
+		tagEnd := 2 + int(rr.rdata[1])
+		record.Type = CAA
+		record.Tag = string(rr.rdata[2:tagEnd])
+		record.Target = string(rr.rdata[tagEnd:])
+	default:
+		return record, false, nil
+	}
+
+	return record, true, err
+}
@@ -0,0 +1,189 @@
+package lingo_test
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/eriktate/lingo"
+)
+
+// dnsName encodes a name without compression. A trailing pointer can be given to compress the rest
+// of the name, as real servers do.
+func dnsName(labels []string, pointer uint16) []byte {
+	var b []byte
+	for _, label := range labels {
+		b = append(b, byte(len(label)))
+		b = append(b, label...)
+	}
+
+	if pointer != 0 {
+		return append(b, byte(0xc0|pointer>>8), byte(pointer))
+	}
+
+	return append(b, 0)
+}
+
+// The offset of the question name in every message, which answers point back to.
+const questionPointer = 12
+
+func dnsRecord(name []byte, rtype uint16, ttl uint32, rdata []byte) []byte {
+	b := append([]byte(nil), name...)
+	header := make([]byte, 10)
+	binary.BigEndian.PutUint16(header, rtype)
+	binary.BigEndian.PutUint16(header[2:], 1)
+	binary.BigEndian.PutUint32(header[4:], ttl)
+	binary.BigEndian.PutUint16(header[8:], uint16(len(rdata)))
+	return append(append(b, header...), rdata...)
+}
+
+func u16(values ...uint16) []byte {
+	b := make([]byte, 2*len(values))
+	for i, v := range values {
+		binary.BigEndian.PutUint16(b[2*i:], v)
+	}
+
+	return b
+}
+
+func u32(values ...uint32) []byte {
+	b := make([]byte, 4*len(values))
+	for i, v := range values {
+		binary.BigEndian.PutUint32(b[4*i:], v)
+	}
+
+	return b
+}
+
+func concat(parts ...[]byte) []byte {
+	var b []byte
+	for _, part := range parts {
+		b = append(b, part...)
+	}
+
+	return b
+}
+
+// startAXFRServer serves a single zone transfer over TCP, split across two messages, or answers
+// with the given rcode instead.
+func startAXFRServer(t *testing.T, rcode uint16) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to start AXFR server: %s", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	apex := dnsName(nil, questionPointer)
+	soa := dnsRecord(apex, 6, 300, concat(
+		dnsName([]string{"ns1", "example", "net"}, 0),
+		dnsName([]string{"host.master"}, questionPointer),
+		u32(2024010101, 7200, 900, 1209600, 300),
+	))
+
+	first := [][]byte{
+		soa,
+		dnsRecord(apex, 2, 300, dnsName([]string{"ns1", "example", "net"}, 0)),
+		dnsRecord(dnsName([]string{"www"}, questionPointer), 1, 300, []byte{192, 0, 2, 1}),
+		dnsRecord(apex, 15, 3600, concat(u16(10), dnsName([]string{"mail"}, questionPointer))),
+		dnsRecord(apex, 16, 300, concat([]byte{7}, []byte("v=spf1 "), []byte{4}, []byte("-all"))),
+		dnsRecord(dnsName([]string{"_sip", "_tcp"}, questionPointer), 33, 300, concat(u16(10, 20, 5060), dnsName([]string{"sip", "example", "com"}, 0))),
+		dnsRecord(apex, 257, 300, concat([]byte{0, 5}, []byte("issue"), []byte("letsencrypt.org"))),
+		dnsRecord(dnsName([]string{"sub"}, questionPointer), 2, 300, dnsName([]string{"ns", "other", "org"}, 0)),
+		dnsRecord(apex, 13, 300, concat([]byte{3}, []byte("cpu"), []byte{2}, []byte("os"))),
+	}
+
+	second := [][]byte{
+		dnsRecord(dnsName([]string{"www"}, questionPointer), 28, 300, net.ParseIP("2001:db8::1")),
+		soa,
+	}
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		var length [2]byte
+		if _, err := io.ReadFull(conn, length[:]); err != nil {
+			return
+		}
+
+		query := make([]byte, binary.BigEndian.Uint16(length[:]))
+		if _, err := io.ReadFull(conn, query); err != nil {
+			return
+		}
+
+		write := func(answers [][]byte) {
+			msg := concat(query[:2], u16(0x8400|rcode, 1, uint16(len(answers)), 0, 0), query[12:])
+			for _, answer := range answers {
+				msg = append(msg, answer...)
+			}
+
+			conn.Write(concat(u16(uint16(len(msg))), msg))
+		}
+
+		if rcode != 0 {
+			write(nil)
+			return
+		}
+
+		write(first)
+		write(second)
+	}()
+
+	return listener.Addr().String()
+}
+
+func Test_TransferZone(t *testing.T) {
+	addr := startAXFRServer(t, 0)
+
+	zone, err := lingo.TransferZone(addr, "example.com")
+	if err != nil {
+		t.Fatalf("Failed to transfer zone: %s", err)
+	}
+
+	expectedDomain := lingo.Domain{
+		Domain:     "example.com",
+		Type:       lingo.DomainTypeMaster,
+		SOA:        "host.master@example.com",
+		TTLSec:     300,
+		RefreshSec: 7200,
+		RetrySec:   900,
+		ExpireSec:  1209600,
+	}
+
+	if !reflect.DeepEqual(zone.Domain, expectedDomain) {
+		t.Fatalf("Expected domain %+v, but got %+v", expectedDomain, zone.Domain)
+	}
+
+	expectedRecords := []lingo.DomainRecord{
+		{Type: lingo.A, Name: "www", Target: "192.0.2.1"},
+		{Type: lingo.MX, Target: "mail.example.com", Priority: 10, TTLSec: 3600},
+		{Type: lingo.TXT, Target: "v=spf1 -all"},
+		{Type: lingo.SRV, Service: "sip", Protocol: "tcp", Priority: 10, Weight: 20, Port: 5060, Target: "sip.example.com"},
+		{Type: lingo.CAA, Tag: "issue", Target: "letsencrypt.org"},
+		{Type: lingo.NS, Name: "sub", Target: "ns.other.org"},
+		{Type: lingo.AAAA, Name: "www", Target: "2001:db8::1"},
+	}
+
+	if !reflect.DeepEqual(zone.Records, expectedRecords) {
+		t.Fatalf("Expected records:\n%+v\nbut got:\n%+v", expectedRecords, zone.Records)
+	}
+
+	// The transferred zone should be exportable as-is.
+	if !strings.Contains(zone.String(), "_sip._tcp\t\tIN\tSRV\t10 20 5060 sip.example.com.") {
+		t.Fatalf("Expected the transferred zone to export cleanly, got:\n%s", zone)
+	}
+}
+
+func Test_TransferZoneRefused(t *testing.T) {
+	addr := startAXFRServer(t, 5)
+
+	if _, err := lingo.TransferZone(addr, "example.com"); err == nil {
+		t.Fatal("Expected a refused transfer to fail")
+	}
+}
@@ -17,24 +17,30 @@ type NodeBalancer struct {
 	Created            Time   `json:"created"`
 	Updated            Time   `json:"updated"`
 	Transfer           Transfer
+	Tags               []string `json:"tags"`
 }
 
 type CreateBalancerRequest struct {
-	Region             string `json:"region"`
-	Label              string `json:"label,omitempty"`
-	ClientConnThrottle uint   `json:"client_conn_throttle,omitempty"`
+	Region             string   `json:"region"`
+	Label              string   `json:"label,omitempty"`
+	ClientConnThrottle uint     `json:"client_conn_throttle,omitempty"`
+	Tags               []string `json:"tags,omitempty"`
 }
 
 // TODO: Not sure if I prefer the approach of including the ID in this
 // struct, or providing to functions that need it.
+//
+// Tags are left alone unless set, and TagList() with no tags clears them.
 type UpdateBalancerRequest struct {
-	ID                 uint   `json:"-"`
-	Label              string `json:"label,omitempty"`
-	ClientConnThrottle uint   `json:"client_conn_throttle,omitempty"`
+	ID                 uint      `json:"-"`
+	Label              string    `json:"label,omitempty"`
+	ClientConnThrottle uint      `json:"client_conn_throttle,omitempty"`
+	Tags               *[]string `json:"tags,omitempty"`
 }
 
 type Balancer interface {
 	GetNodeBalancers() ([]NodeBalancer, error)
+	GetNodeBalancersByTag(tag string) ([]NodeBalancer, error)
 	GetNodeBalancer(id string) (NodeBalancer, error)
 	CreateNodeBalancer(req CreateBalancerRequest) (NodeBalancer, error)
 	UpdateNodeBalancer(req UpdateBalancerRequest) (NodeBalancer, error)
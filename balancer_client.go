@@ -34,6 +34,26 @@ func (c BalancerClient) GetNodeBalancers() ([]NodeBalancer, error) {
 	return balancers, nil
 }
 
+// GetNodeBalancersByTag retrieves a slice of the NodeBalancers carrying the given tag.
+func (c BalancerClient) GetNodeBalancersByTag(tag string) ([]NodeBalancer, error) {
+	data, err := c.api.GetFiltered("nodebalancers", TagFilter(tag))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make request for GetNodeBalancersByTag")
+	}
+
+	var results Results
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal GetNodeBalancersByTag response")
+	}
+
+	var balancers []NodeBalancer
+	if err := json.Unmarshal(results.Data, &balancers); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal GetNodeBalancersByTag data")
+	}
+
+	return balancers, nil
+}
+
 func (c BalancerClient) GetNodeBalancer(id uint) (NodeBalancer, error) {
 	var balancer NodeBalancer
 	data, err := c.api.Get(fmt.Sprintf("nodebalancers/%d", id))
@@ -0,0 +1,108 @@
+package lingo
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// An SSHBootstrap creates and rebuilds Linodes with an SSH key already authorized, and waits until
+// they're reachable over SSH. Once a Linode is running, its public IPv4 address is scanned until
+// SSH completes a handshake, and the host key is captured so the first login can be checked
+// against it rather than trusted blindly.
+type SSHBootstrap struct {
+	linodes Linoder
+
+	// Key is authorized on every Linode the SSHBootstrap creates or rebuilds.
+	Key SSHKey
+
+	// Port is the port SSH listens on.
+	Port uint16
+
+	// PollingInterval is how often to check on the Linode's status and retry SSH.
+	PollingInterval time.Duration
+}
+
+// NewSSHBootstrap returns a new SSHBootstrap given a valid Linoder implementation and the key to
+// authorize.
+func NewSSHBootstrap(linodes Linoder, key SSHKey) SSHBootstrap {
+	return SSHBootstrap{
+		linodes:         linodes,
+		Key:             key,
+		Port:            sshDefaultPort,
+		PollingInterval: 5 * time.Second,
+	}
+}
+
+// Create creates a booted Linode with the key authorized and waits until it's reachable over SSH.
+func (b SSHBootstrap) Create(ctx context.Context, req CreateLinodeRequest) (Linode, KnownHost, error) {
+	if req.Image == "" {
+		return Linode{}, KnownHost{}, errors.New("a Linode needs an image to authorize SSH keys")
+	}
+
+	req.AuthorizedKeys = withAuthorizedKey(req.AuthorizedKeys, b.Key.AuthorizedKey())
+	req.Booted = true
+
+	created, err := b.linodes.CreateLinode(req)
+	if err != nil {
+		return created, KnownHost{}, err
+	}
+
+	linode, known, err := b.Wait(ctx, created.ID)
+	linode.RootPass = created.RootPass
+	return linode, known, err
+}
+
+// Rebuild rebuilds a Linode with the key authorized and waits until it's reachable over SSH. A
+// rebuild generates new host keys, so any old known_hosts entry for the Linode should be replaced
+// with the one returned.
+func (b SSHBootstrap) Rebuild(ctx context.Context, req RebuildLinodeRequest) (Linode, KnownHost, error) {
+	req.AuthorizedKeys = withAuthorizedKey(req.AuthorizedKeys, b.Key.AuthorizedKey())
+	req.Booted = true
+
+	rebuilt, err := b.linodes.RebuildLinode(req)
+	if err != nil {
+		return rebuilt, KnownHost{}, err
+	}
+
+	linode, known, err := b.Wait(ctx, req.ID)
+	linode.RootPass = rebuilt.RootPass
+	return linode, known, err
+}
+
+// Wait waits for a Linode to be running and for SSH on its public IPv4 address to complete a
+// handshake, returning its host key.
+func (b SSHBootstrap) Wait(ctx context.Context, linodeID uint) (Linode, KnownHost, error) {
+	linode, err := waitForLinodeStatus(ctx, b.linodes, linodeID, b.PollingInterval, StatusRunning)
+	if err != nil {
+		return linode, KnownHost{}, err
+	}
+
+	var address string
+	for _, addr := range publicAddresses(linode) {
+		if addr.Is4() {
+			address = net.JoinHostPort(addr.String(), strconv.Itoa(int(b.Port)))
+			break
+		}
+	}
+
+	if address == "" {
+		return linode, KnownHost{}, errors.Errorf("Linode %s has no public IPv4 address", linode.Label)
+	}
+
+	for {
+		known, err := ScanHostKey(ctx, address)
+		if err == nil {
+			return linode, known, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return linode, known, errors.Wrapf(err, "gave up waiting on SSH for Linode %s", linode.Label)
+		case <-time.After(b.PollingInterval):
+		}
+	}
+}
@@ -16,6 +16,7 @@ const baseURI = "https://api.linode.com/v4/"
 // An APIClient is capable of making API calls to the Linode API.
 type APIClient struct {
 	apiKey  string
+	baseURI string
 	backoff *backoffConfig
 	h       *http.Client
 }
@@ -32,9 +33,16 @@ type Results struct {
 // API key.
 func NewAPIClient(apiKey string, backoff *backoffConfig) APIClient {
 	// TODO: Build a Client struct here instead of using the default.
+	return NewAPIClientWithURI(apiKey, baseURI)
+}
+
+// NewAPIClientWithURI returns a new Linode client struct loaded with the given API key that makes
+// calls against an alternative API endpoint, such as a test server. The URI should end in a slash.
+func NewAPIClientWithURI(apiKey, uri string) APIClient {
 	return APIClient{
-		apiKey: apiKey,
-		h:      http.DefaultClient,
+		apiKey:  apiKey,
+		baseURI: uri,
+		h:       http.DefaultClient,
 	}
 }
 
@@ -48,6 +56,23 @@ func (c APIClient) Get(path string) ([]byte, error) {
 	return c.do(req)
 }
 
+// GetFiltered makes a GET request with the given Filter applied through the X-Filter header.
+func (c APIClient) GetFiltered(path string, filter Filter) ([]byte, error) {
+	req, err := c.makeGetRequest(path)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-Filter", string(data))
+
+	return c.do(req)
+}
+
 func (c APIClient) Post(path string, payload []byte) ([]byte, error) {
 	req, err := c.makePostRequest(path, payload)
 	if err != nil {
@@ -57,6 +82,19 @@ func (c APIClient) Post(path string, payload []byte) ([]byte, error) {
 	return c.do(req)
 }
 
+// PostMultipart makes a POST request with a body that has already been encoded as
+// multipart/form-data, such as a file upload.
+func (c APIClient) PostMultipart(path, contentType string, payload []byte) ([]byte, error) {
+	req, err := c.makePostRequest(path, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", contentType)
+
+	return c.do(req)
+}
+
 func (c APIClient) Put(path string, payload []byte) ([]byte, error) {
 	req, err := c.makePutRequest(path, payload)
 	if err != nil {
@@ -111,7 +149,7 @@ func (c APIClient) do(req *http.Request) ([]byte, error) {
 }
 
 func (c APIClient) makeGetRequest(path string) (*http.Request, error) {
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s%s", baseURI, path), nil)
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s%s", c.baseURI, path), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -122,7 +160,7 @@ func (c APIClient) makeGetRequest(path string) (*http.Request, error) {
 }
 
 func (c APIClient) makePostRequest(path string, data []byte) (*http.Request, error) {
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s%s", baseURI, path), bytes.NewBuffer(data))
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s%s", c.baseURI, path), bytes.NewBuffer(data))
 	if err != nil {
 		return nil, err
 	}
@@ -134,7 +172,7 @@ func (c APIClient) makePostRequest(path string, data []byte) (*http.Request, err
 }
 
 func (c APIClient) makePutRequest(path string, data []byte) (*http.Request, error) {
-	req, err := http.NewRequest(http.MethodPut, fmt.Sprintf("%s%s", baseURI, path), bytes.NewBuffer(data))
+	req, err := http.NewRequest(http.MethodPut, fmt.Sprintf("%s%s", c.baseURI, path), bytes.NewBuffer(data))
 	if err != nil {
 		return nil, err
 	}
@@ -146,7 +184,7 @@ func (c APIClient) makePutRequest(path string, data []byte) (*http.Request, erro
 }
 
 func (c APIClient) makeDeleteRequest(path string) (*http.Request, error) {
-	req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s%s", baseURI, path), nil)
+	req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s%s", c.baseURI, path), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -166,6 +204,17 @@ type Lingo struct {
 	DomainClient
 	VolumeClient
 	DiskClient
+	ObjectStorageClient
+	LKEClient
+	TagClient
+	SupportClient
+	LongviewClient
+	ManagedClient
+	NetworkClient
+	VPCClient
+	ConfigClient
+	EventClient
+	KernelClient
 }
 
 // NewLingo returns a new Lingo struct given a Linode API key.
@@ -173,12 +222,23 @@ func NewLingo(apiKey string, backoff *backoffConfig) Lingo {
 	api := NewAPIClient(apiKey, backoff)
 
 	return Lingo{
-		LinodeClient:   NewLinodeClient(api),
-		BalancerClient: NewBalancerClient(api),
-		ImageClient:    NewImageClient(api),
-		RegionClient:   NewRegionClient(api),
-		DomainClient:   NewDomainClient(api),
-		VolumeClient:   NewVolumeClient(api),
-		DiskClient:     NewDiskClient(api),
+		LinodeClient:        NewLinodeClient(api),
+		BalancerClient:      NewBalancerClient(api),
+		ImageClient:         NewImageClient(api),
+		RegionClient:        NewRegionClient(api),
+		DomainClient:        NewDomainClient(api),
+		VolumeClient:        NewVolumeClient(api),
+		DiskClient:          NewDiskClient(api),
+		ObjectStorageClient: NewObjectStorageClient(api),
+		LKEClient:           NewLKEClient(api),
+		TagClient:           NewTagClient(api),
+		SupportClient:       NewSupportClient(api),
+		LongviewClient:      NewLongviewClient(api),
+		ManagedClient:       NewManagedClient(api),
+		NetworkClient:       NewNetworkClient(api),
+		VPCClient:           NewVPCClient(api),
+		ConfigClient:        NewConfigClient(api),
+		EventClient:         NewEventClient(api),
+		KernelClient:        NewKernelClient(api),
 	}
 }
@@ -0,0 +1,215 @@
+package lingo
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+)
+
+// MaxUserDataBytes is the most user data the metadata service takes, measured after base64
+// encoding.
+const MaxUserDataBytes = 16 * 1024
+
+// cloudConfigHeader is the first line of a #cloud-config document.
+const cloudConfigHeader = "#cloud-config"
+
+// userDataHeaders are the headers cloud-init recognizes at the start of user data, other than
+// #cloud-config.
+var userDataHeaders = []string{
+	"#!",
+	"#include",
+	"#cloud-boothook",
+	"#cloud-config-archive",
+	"#cloud-config-jsonp",
+	"#part-handler",
+	"Content-Type: multipart/",
+}
+
+// Metadata is what's handed to the metadata service when a Linode is created or rebuilt.
+type Metadata struct {
+	UserData string `json:"user_data,omitempty"`
+}
+
+// NewMetadata validates user data and base64 encodes it for the metadata service.
+func NewMetadata(userData []byte) (*Metadata, error) {
+	if err := ValidateUserData(userData); err != nil {
+		return nil, err
+	}
+
+	return &Metadata{UserData: base64.StdEncoding.EncodeToString(userData)}, nil
+}
+
+// ValidateUserData checks user data is small enough for the metadata service and starts with a
+// header cloud-init understands. #cloud-config documents are also checked for tab indentation,
+// which YAML doesn't allow.
+func ValidateUserData(userData []byte) error {
+	if len(userData) == 0 {
+		return errors.New("user data is empty")
+	}
+
+	if size := base64.StdEncoding.EncodedLen(len(userData)); size > MaxUserDataBytes {
+		return errors.Errorf("user data is %d bytes encoded, but the limit is %d", size, MaxUserDataBytes)
+	}
+
+	// Gzipped user data is unpacked by cloud-init before its header is read.
+	if bytes.HasPrefix(userData, []byte{0x1f, 0x8b}) {
+		return nil
+	}
+
+	firstLine, _, _ := strings.Cut(string(userData), "\n")
+	firstLine = strings.TrimRight(firstLine, " \r")
+	if firstLine == cloudConfigHeader {
+		return validateCloudConfig(string(userData))
+	}
+
+	for _, header := range userDataHeaders {
+		if strings.HasPrefix(firstLine, header) {
+			return nil
+		}
+	}
+
+	return errors.Errorf("user data starts with %q, which cloud-init doesn't recognize; start it with %s or a script's #!", firstLine, cloudConfigHeader)
+}
+
+func validateCloudConfig(doc string) error {
+	for i, line := range strings.Split(doc, "\n") {
+		indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+		if strings.Contains(indent, "\t") {
+			return errors.Errorf("line %d of the cloud-config is indented with a tab", i+1)
+		}
+	}
+
+	return nil
+}
+
+// RenderUserData renders user data from a text/template, such as a #cloud-config document with
+// values filled in per Linode.
+func RenderUserData(text string, data interface{}) ([]byte, error) {
+	tmpl, err := template.New("user-data").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse user data template")
+	}
+
+	var b bytes.Buffer
+	if err := tmpl.Execute(&b, data); err != nil {
+		return nil, errors.Wrap(err, "failed to render user data template")
+	}
+
+	return b.Bytes(), nil
+}
+
+// A CloudConfigUser is a user cloud-init creates.
+type CloudConfigUser struct {
+	Name              string
+	Groups            []string
+	Shell             string
+	Sudo              string
+	SSHAuthorizedKeys []string
+}
+
+// A CloudConfigFile is a file cloud-init writes. Permissions are given in octal, such as "0644".
+type CloudConfigFile struct {
+	Path        string
+	Content     string
+	Owner       string
+	Permissions string
+	Append      bool
+}
+
+// A CloudConfig is a #cloud-config document covering the modules most Linodes need. Empty fields
+// are left out so cloud-init's defaults apply.
+type CloudConfig struct {
+	Hostname          string
+	FQDN              string
+	ManageEtcHosts    bool
+	Timezone          string
+	Users             []CloudConfigUser
+	SSHAuthorizedKeys []string
+	PackageUpdate     bool
+	PackageUpgrade    bool
+	Packages          []string
+	WriteFiles        []CloudConfigFile
+	BootCmd           []string
+	RunCmd            []string
+}
+
+// String renders the CloudConfig as a #cloud-config document. Every string is double quoted, so
+// values never need escaping by hand.
+func (c CloudConfig) String() string {
+	var b strings.Builder
+	b.WriteString(cloudConfigHeader + "\n")
+
+	writeYAMLString(&b, "", "hostname", c.Hostname)
+	writeYAMLString(&b, "", "fqdn", c.FQDN)
+	writeYAMLBool(&b, "", "manage_etc_hosts", c.ManageEtcHosts)
+	writeYAMLString(&b, "", "timezone", c.Timezone)
+
+	if len(c.Users) > 0 {
+		b.WriteString("users:\n")
+		for _, user := range c.Users {
+			fmt.Fprintf(&b, "  - name: %s\n", strconv.Quote(user.Name))
+			if len(user.Groups) > 0 {
+				fmt.Fprintf(&b, "    groups: %s\n", strconv.Quote(strings.Join(user.Groups, ", ")))
+			}
+			writeYAMLString(&b, "    ", "shell", user.Shell)
+			writeYAMLString(&b, "    ", "sudo", user.Sudo)
+			writeYAMLList(&b, "    ", "ssh_authorized_keys", user.SSHAuthorizedKeys)
+		}
+	}
+
+	writeYAMLList(&b, "", "ssh_authorized_keys", c.SSHAuthorizedKeys)
+	writeYAMLBool(&b, "", "package_update", c.PackageUpdate)
+	writeYAMLBool(&b, "", "package_upgrade", c.PackageUpgrade)
+	writeYAMLList(&b, "", "packages", c.Packages)
+
+	if len(c.WriteFiles) > 0 {
+		b.WriteString("write_files:\n")
+		for _, file := range c.WriteFiles {
+			fmt.Fprintf(&b, "  - path: %s\n", strconv.Quote(file.Path))
+			fmt.Fprintf(&b, "    content: %s\n", strconv.Quote(file.Content))
+			writeYAMLString(&b, "    ", "owner", file.Owner)
+			writeYAMLString(&b, "    ", "permissions", file.Permissions)
+			writeYAMLBool(&b, "    ", "append", file.Append)
+		}
+	}
+
+	writeYAMLList(&b, "", "bootcmd", c.BootCmd)
+	writeYAMLList(&b, "", "runcmd", c.RunCmd)
+
+	return b.String()
+}
+
+// Metadata renders the CloudConfig and returns it as Metadata for a create or rebuild request.
+func (c CloudConfig) Metadata() (*Metadata, error) {
+	return NewMetadata([]byte(c.String()))
+}
+
+// Go's quoted strings only use escapes YAML's double quoted scalars share, so strconv.Quote is
+// safe to use for YAML values.
+func writeYAMLString(b *strings.Builder, indent, key, value string) {
+	if value != "" {
+		fmt.Fprintf(b, "%s%s: %s\n", indent, key, strconv.Quote(value))
+	}
+}
+
+func writeYAMLBool(b *strings.Builder, indent, key string, value bool) {
+	if value {
+		fmt.Fprintf(b, "%s%s: true\n", indent, key)
+	}
+}
+
+func writeYAMLList(b *strings.Builder, indent, key string, values []string) {
+	if len(values) == 0 {
+		return
+	}
+
+	fmt.Fprintf(b, "%s%s:\n", indent, key)
+	for _, v := range values {
+		fmt.Fprintf(b, "%s  - %s\n", indent, strconv.Quote(v))
+	}
+}
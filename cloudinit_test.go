@@ -0,0 +1,135 @@
+package lingo_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/eriktate/lingo"
+)
+
+func Test_CloudConfig(t *testing.T) {
+	config := lingo.CloudConfig{
+		Hostname:       "web-1",
+		ManageEtcHosts: true,
+		Users: []lingo.CloudConfigUser{{
+			Name:              "deploy",
+			Groups:            []string{"sudo", "docker"},
+			Shell:             "/bin/bash",
+			Sudo:              "ALL=(ALL) NOPASSWD:ALL",
+			SSHAuthorizedKeys: []string{"ssh-ed25519 AAAAkey deploy@lingo"},
+		}},
+		PackageUpdate: true,
+		Packages:      []string{"nginx"},
+		WriteFiles: []lingo.CloudConfigFile{{
+			Path:        "/etc/motd",
+			Content:     "hello: \"world\"\n",
+			Permissions: "0644",
+		}},
+		RunCmd: []string{"systemctl enable --now nginx"},
+	}
+
+	expected := `#cloud-config
+hostname: "web-1"
+manage_etc_hosts: true
+users:
+  - name: "deploy"
+    groups: "sudo, docker"
+    shell: "/bin/bash"
+    sudo: "ALL=(ALL) NOPASSWD:ALL"
+    ssh_authorized_keys:
+      - "ssh-ed25519 AAAAkey deploy@lingo"
+package_update: true
+packages:
+  - "nginx"
+write_files:
+  - path: "/etc/motd"
+    content: "hello: \"world\"\n"
+    permissions: "0644"
+runcmd:
+  - "systemctl enable --now nginx"
+`
+	if config.String() != expected {
+		t.Fatalf("expected:\n%s\ngot:\n%s", expected, config.String())
+	}
+
+	metadata, err := config.Metadata()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := json.Marshal(lingo.CreateLinodeRequest{Region: "us-ord", Type: "g6-nanode-1", Metadata: metadata})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encoded := base64.StdEncoding.EncodeToString([]byte(expected))
+	if !strings.Contains(string(data), `"metadata":{"user_data":"`+encoded+`"}`) {
+		t.Fatalf("metadata missing from request: %s", data)
+	}
+
+	data, err = json.Marshal(lingo.CreateLinodeRequest{Region: "us-ord", Type: "g6-nanode-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Contains(string(data), "metadata") {
+		t.Fatalf("expected no metadata in request: %s", data)
+	}
+}
+
+func Test_ValidateUserData(t *testing.T) {
+	valid := []string{
+		"#cloud-config\npackages:\n  - nginx\n",
+		"#!/bin/sh\necho hello\n",
+		"Content-Type: multipart/mixed; boundary=\"x\"\n",
+		"\x1f\x8b\x08\x00",
+	}
+
+	for _, userData := range valid {
+		if err := lingo.ValidateUserData([]byte(userData)); err != nil {
+			t.Errorf("expected %q to be valid: %s", userData, err)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"packages:\n  - nginx\n",
+		"#cloud-config\npackages:\n\t- nginx\n",
+		"#cloud-config\n" + strings.Repeat("#", lingo.MaxUserDataBytes),
+	}
+
+	for _, userData := range invalid {
+		if err := lingo.ValidateUserData([]byte(userData)); err == nil {
+			t.Errorf("expected %.30q to be invalid", userData)
+		}
+	}
+}
+
+func Test_RenderUserData(t *testing.T) {
+	userData, err := lingo.RenderUserData("#cloud-config\nhostname: {{ printf \"%q\" .Hostname }}\n", map[string]string{"Hostname": "web-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(userData) != "#cloud-config\nhostname: \"web-1\"\n" {
+		t.Fatalf("unexpected user data:\n%s", userData)
+	}
+
+	if _, err := lingo.RenderUserData("#cloud-config\nhostname: {{ .Missing }}\n", map[string]string{}); err == nil {
+		t.Fatal("expected an error rendering a missing key")
+	}
+}
+
+func Test_RegionSupportsMetadata(t *testing.T) {
+	region := lingo.Region{ID: "us-ord", Capabilities: []string{"Linodes", "Metadata"}}
+	if !region.SupportsMetadata() {
+		t.Fatal("expected us-ord to support metadata")
+	}
+
+	region = lingo.Region{ID: "us-east", Capabilities: []string{"Linodes", "Block Storage"}}
+	if region.SupportsMetadata() || !region.HasCapability("Block Storage") {
+		t.Fatal("unexpected capabilities for us-east")
+	}
+}
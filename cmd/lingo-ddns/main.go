@@ -0,0 +1,261 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/eriktate/lingo"
+)
+
+// config holds everything lingo-ddns is told on the command line.
+type config struct {
+	domain     string
+	names      []string
+	ipv4       bool
+	ipv6       bool
+	ipv4Source string
+	ipv6Source string
+	iface      string
+	interval   time.Duration
+	ttl        uint
+	once       bool
+}
+
+// updater keeps the A and AAAA records for a set of names in sync with the host's addresses.
+type updater struct {
+	cfg      config
+	client   lingo.Domainer
+	http     *http.Client
+	log      *slog.Logger
+	domainID uint
+
+	// The last address seen for each record type, so unchanged addresses cost no API calls.
+	last map[lingo.DomainRecordType]string
+}
+
+func main() {
+	var (
+		cfg   config
+		names string
+	)
+
+	flag.StringVar(&cfg.domain, "domain", "", "the Linode domain to update records in (required)")
+	flag.StringVar(&names, "name", "", "comma separated record names to keep updated, relative to the domain (empty for the apex)")
+	flag.BoolVar(&cfg.ipv4, "ipv4", true, "keep A records updated")
+	flag.BoolVar(&cfg.ipv6, "ipv6", false, "keep AAAA records updated")
+	flag.StringVar(&cfg.ipv4Source, "ipv4-source", "https://api.ipify.org", "URL that responds with the public IPv4 address")
+	flag.StringVar(&cfg.ipv6Source, "ipv6-source", "https://api6.ipify.org", "URL that responds with the public IPv6 address")
+	flag.StringVar(&cfg.iface, "interface", "", "read addresses from this local interface instead of the source URLs")
+	flag.DurationVar(&cfg.interval, "interval", 5*time.Minute, "how often to check for address changes")
+	flag.UintVar(&cfg.ttl, "ttl", 300, "TTL for the records, in seconds")
+	flag.BoolVar(&cfg.once, "once", false, "update once and exit instead of polling")
+	flag.Parse()
+
+	logger := slog.New(slog.NewJSONHandler(os.Stderr, nil))
+
+	cfg.names = strings.Split(names, ",")
+	if cfg.domain == "" || (!cfg.ipv4 && !cfg.ipv6) {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	apiKey := os.Getenv("LINODE_API_KEY")
+	if apiKey == "" {
+		logger.Error("LINODE_API_KEY must be set")
+		os.Exit(1)
+	}
+
+	u := &updater{
+		cfg:    cfg,
+		client: lingo.NewDomainClient(lingo.NewAPIClient(apiKey, nil)),
+		http:   &http.Client{Timeout: 10 * time.Second},
+		log:    logger.With("domain", cfg.domain),
+		last:   make(map[lingo.DomainRecordType]string),
+	}
+
+	if err := u.findDomain(); err != nil {
+		logger.Error("failed to find domain", "domain", cfg.domain, "error", err)
+		os.Exit(1)
+	}
+
+	u.update()
+	if cfg.once {
+		return
+	}
+
+	ticker := time.NewTicker(cfg.interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		u.update()
+	}
+}
+
+// findDomain looks up the ID of the configured domain.
+func (u *updater) findDomain() error {
+	domains, err := u.client.ListDomains()
+	if err != nil {
+		return err
+	}
+
+	for _, domain := range domains {
+		if strings.EqualFold(domain.Domain, u.cfg.domain) {
+			u.domainID = domain.ID
+			return nil
+		}
+	}
+
+	return fmt.Errorf("no domain named %s", u.cfg.domain)
+}
+
+// update detects the current addresses and syncs any that changed since the last check. Failures
+// are logged and retried on the next tick.
+func (u *updater) update() {
+	families := []struct {
+		enabled    bool
+		recordType lingo.DomainRecordType
+		source     string
+	}{
+		{u.cfg.ipv4, lingo.A, u.cfg.ipv4Source},
+		{u.cfg.ipv6, lingo.AAAA, u.cfg.ipv6Source},
+	}
+
+	for _, family := range families {
+		if !family.enabled {
+			continue
+		}
+
+		log := u.log.With("type", string(family.recordType))
+
+		ip, err := u.detect(family.recordType, family.source)
+		if err != nil {
+			log.Error("failed to detect address", "error", err)
+			continue
+		}
+
+		if u.last[family.recordType] == ip {
+			log.Debug("address unchanged", "ip", ip)
+			continue
+		}
+
+		if err := u.sync(family.recordType, ip); err != nil {
+			log.Error("failed to update records", "ip", ip, "error", err)
+			continue
+		}
+
+		u.last[family.recordType] = ip
+	}
+}
+
+// detect returns the current address of the given record type's family, either from the local
+// interface or from the source URL.
+func (u *updater) detect(recordType lingo.DomainRecordType, source string) (string, error) {
+	wantV4 := recordType == lingo.A
+
+	if u.cfg.iface != "" {
+		return interfaceAddress(u.cfg.iface, wantV4)
+	}
+
+	res, err := u.http.Get(source)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%s responded with status %d", source, res.StatusCode)
+	}
+
+	ip := net.ParseIP(strings.TrimSpace(string(body)))
+	if ip == nil || (ip.To4() != nil) != wantV4 {
+		return "", fmt.Errorf("%s didn't respond with an %s address", source, recordType)
+	}
+
+	return ip.String(), nil
+}
+
+// interfaceAddress returns the first public address of the given family on a local interface.
+func interfaceAddress(name string, wantV4 bool) (string, error) {
+	iface, err := net.InterfaceByName(name)
+	if err != nil {
+		return "", err
+	}
+
+	addrs, err := iface.Addrs()
+	if err != nil {
+		return "", err
+	}
+
+	for _, addr := range addrs {
+		ipNet, ok := addr.(*net.IPNet)
+		if !ok || (ipNet.IP.To4() != nil) != wantV4 {
+			continue
+		}
+
+		if ipNet.IP.IsGlobalUnicast() && !ipNet.IP.IsPrivate() {
+			return ipNet.IP.String(), nil
+		}
+	}
+
+	return "", fmt.Errorf("interface %s has no public address of the right family", name)
+}
+
+// sync points every configured name's record of the given type at the address, creating records
+// that don't exist yet and leaving records that are already correct alone.
+func (u *updater) sync(recordType lingo.DomainRecordType, ip string) error {
+	records, err := u.client.ListDomainRecords(u.domainID)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range u.cfg.names {
+		name = strings.TrimSpace(name)
+		log := u.log.With("type", string(recordType), "name", name, "ip", ip)
+
+		var existing *lingo.DomainRecord
+		for i := range records {
+			if records[i].Type == recordType && strings.EqualFold(records[i].Name, name) {
+				existing = &records[i]
+				break
+			}
+		}
+
+		if existing == nil {
+			record := lingo.DomainRecord{Type: recordType, Name: name, Target: ip, TTLSec: u.cfg.ttl}
+			if _, err := u.client.CreateDomainRecord(u.domainID, record); err != nil {
+				return err
+			}
+
+			log.Info("created record")
+			continue
+		}
+
+		if existing.Target == ip {
+			log.Debug("record already up to date")
+			continue
+		}
+
+		previous := existing.Target
+		existing.Target = ip
+		existing.TTLSec = u.cfg.ttl
+		if _, err := u.client.UpdateDomainRecord(u.domainID, *existing); err != nil {
+			return err
+		}
+
+		log.Info("updated record", "previous", previous)
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/eriktate/lingo"
+)
+
+// fakeDomains is an in-memory Domainer holding a single domain's records.
+type fakeDomains struct {
+	lingo.Domainer
+	domains []lingo.Domain
+	records []lingo.DomainRecord
+	nextID  uint
+	creates int
+	updates int
+}
+
+func (f *fakeDomains) ListDomains() ([]lingo.Domain, error) {
+	return f.domains, nil
+}
+
+func (f *fakeDomains) ListDomainRecords(domainID uint) ([]lingo.DomainRecord, error) {
+	return append([]lingo.DomainRecord(nil), f.records...), nil
+}
+
+func (f *fakeDomains) CreateDomainRecord(domainID uint, record lingo.DomainRecord) (lingo.DomainRecord, error) {
+	f.nextID++
+	f.creates++
+	record.ID = f.nextID
+	f.records = append(f.records, record)
+	return record, nil
+}
+
+func (f *fakeDomains) UpdateDomainRecord(domainID uint, record lingo.DomainRecord) (lingo.DomainRecord, error) {
+	f.updates++
+	for i := range f.records {
+		if f.records[i].ID == record.ID {
+			f.records[i] = record
+			return record, nil
+		}
+	}
+
+	return record, fmt.Errorf("no record %d", record.ID)
+}
+
+func newTestUpdater(domains lingo.Domainer, names ...string) *updater {
+	return &updater{
+		cfg:    config{domain: "example.com", names: names, ipv4: true, ttl: 300},
+		client: domains,
+		http:   http.DefaultClient,
+		log:    slog.New(slog.NewTextHandler(io.Discard, nil)),
+		last:   make(map[lingo.DomainRecordType]string),
+	}
+}
+
+func Test_FindDomain(t *testing.T) {
+	domains := &fakeDomains{domains: []lingo.Domain{{ID: 1, Domain: "other.com"}, {ID: 2, Domain: "Example.com"}}}
+	u := newTestUpdater(domains, "home")
+
+	if err := u.findDomain(); err != nil {
+		t.Fatal(err)
+	}
+
+	if u.domainID != 2 {
+		t.Fatalf("Expected domain 2, but got %d", u.domainID)
+	}
+
+	u.cfg.domain = "missing.com"
+	if err := u.findDomain(); err == nil {
+		t.Fatal("Expected an error finding a missing domain")
+	}
+}
+
+func Test_Detect(t *testing.T) {
+	response := "203.0.113.7\n"
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		fmt.Fprint(w, response)
+	}))
+	defer server.Close()
+
+	u := newTestUpdater(&fakeDomains{})
+
+	ip, err := u.detect(lingo.A, server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ip != "203.0.113.7" {
+		t.Fatalf("Expected 203.0.113.7, but got %s", ip)
+	}
+
+	if _, err := u.detect(lingo.AAAA, server.URL); err == nil {
+		t.Fatal("Expected an error detecting an IPv6 address from an IPv4 response")
+	}
+
+	response = "2001:db8::7"
+	ip, err = u.detect(lingo.AAAA, server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ip != "2001:db8::7" {
+		t.Fatalf("Expected 2001:db8::7, but got %s", ip)
+	}
+
+	response = "not an address"
+	if _, err := u.detect(lingo.A, server.URL); err == nil {
+		t.Fatal("Expected an error detecting from a garbage response")
+	}
+
+	response, status = "203.0.113.7", http.StatusServiceUnavailable
+	if _, err := u.detect(lingo.A, server.URL); err == nil {
+		t.Fatal("Expected an error detecting from a failing source")
+	}
+}
+
+func Test_InterfaceAddress(t *testing.T) {
+	if _, err := interfaceAddress("lingo-missing0", true); err == nil {
+		t.Fatal("Expected an error reading a missing interface")
+	}
+
+	// Loopback only has loopback addresses, which are never public.
+	if ip, err := interfaceAddress("lo", true); err == nil {
+		t.Fatalf("Expected no public address on loopback, but got %s", ip)
+	}
+}
+
+func Test_Sync(t *testing.T) {
+	domains := &fakeDomains{
+		nextID: 10,
+		records: []lingo.DomainRecord{
+			{ID: 1, Type: lingo.A, Name: "home", Target: "203.0.113.1", TTLSec: 300},
+			{ID: 2, Type: lingo.A, Name: "vpn", Target: "203.0.113.7", TTLSec: 300},
+			{ID: 3, Type: lingo.AAAA, Name: "nas", Target: "2001:db8::1", TTLSec: 300},
+		},
+	}
+	u := newTestUpdater(domains, "home", " vpn", "nas")
+
+	if err := u.sync(lingo.A, "203.0.113.7"); err != nil {
+		t.Fatal(err)
+	}
+
+	if domains.creates != 1 || domains.updates != 1 {
+		t.Fatalf("Expected 1 create and 1 update, but got %d and %d", domains.creates, domains.updates)
+	}
+
+	for _, record := range domains.records {
+		if record.Type == lingo.A && record.Target != "203.0.113.7" {
+			t.Fatalf("Record %s wasn't updated: %+v", record.Name, record)
+		}
+	}
+
+	if domains.records[2].Target != "2001:db8::1" {
+		t.Fatalf("AAAA record was changed: %+v", domains.records[2])
+	}
+
+	if err := u.sync(lingo.A, "203.0.113.7"); err != nil {
+		t.Fatal(err)
+	}
+
+	if domains.creates != 1 || domains.updates != 1 {
+		t.Fatalf("Expected no more calls once records are up to date, but got %d creates and %d updates", domains.creates, domains.updates)
+	}
+}
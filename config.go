@@ -0,0 +1,343 @@
+package lingo
+
+import (
+	"net/netip"
+	"regexp"
+
+	"github.com/pkg/errors"
+)
+
+// A RunLevel is an enumeration of possible run levels a Linode config can boot into.
+type RunLevel string
+
+// Enum values for RunLevel.
+const (
+	RunLevelDefault = RunLevel("default")
+	RunLevelSingle  = RunLevel("single")
+	RunLevelBinBash = RunLevel("binbash")
+)
+
+// A VirtMode is an enumeration of possible virtualization modes for a Linode config.
+type VirtMode string
+
+// Enum values for VirtMode.
+const (
+	VirtModeParavirt = VirtMode("paravirt")
+	VirtModeFullvirt = VirtMode("fullvirt")
+)
+
+// An InterfacePurpose is an enumeration of the kinds of network a configuration interface can
+// attach a Linode to.
+type InterfacePurpose string
+
+// Enum values for InterfacePurpose.
+const (
+	InterfacePurposePublic = InterfacePurpose("public")
+	InterfacePurposeVLAN   = InterfacePurpose("vlan")
+	InterfacePurposeVPC    = InterfacePurpose("vpc")
+)
+
+// NAT1To1Any asks for a VPC interface to be given a public IPv4 address that's translated 1:1 to
+// its VPC address.
+const NAT1To1Any = "any"
+
+// MaxConfigInterfaces is the most configuration interfaces a single Linode config can have.
+const MaxConfigInterfaces = 3
+
+// A ConfigDevice is a disk or volume attached to a Linode config. Only one of the IDs is set.
+type ConfigDevice struct {
+	DiskID   uint `json:"disk_id,omitempty"`
+	VolumeID uint `json:"volume_id,omitempty"`
+}
+
+// ConfigDevices maps the block devices of a Linode config to disks and volumes.
+type ConfigDevices struct {
+	SDA *ConfigDevice `json:"sda,omitempty"`
+	SDB *ConfigDevice `json:"sdb,omitempty"`
+	SDC *ConfigDevice `json:"sdc,omitempty"`
+	SDD *ConfigDevice `json:"sdd,omitempty"`
+	SDE *ConfigDevice `json:"sde,omitempty"`
+	SDF *ConfigDevice `json:"sdf,omitempty"`
+	SDG *ConfigDevice `json:"sdg,omitempty"`
+	SDH *ConfigDevice `json:"sdh,omitempty"`
+}
+
+// ConfigHelpers are the boot helpers Linode can apply to a config.
+type ConfigHelpers struct {
+	UpdateDBDisabled  bool `json:"updatedb_disabled"`
+	Distro            bool `json:"distro"`
+	ModulesDep        bool `json:"modules_dep"`
+	Network           bool `json:"network"`
+	DevTmpFSAutomount bool `json:"devtmpfs_automount"`
+}
+
+// ConfigInterfaceIPv4 holds the IPv4 settings of a VPC interface. An empty VPC address is assigned
+// automatically from the subnet, and NAT1To1 can be NAT1To1Any or a public address the Linode
+// already has.
+type ConfigInterfaceIPv4 struct {
+	VPC     string `json:"vpc,omitempty"`
+	NAT1To1 string `json:"nat_1_1,omitempty"`
+}
+
+// A ConfigInterface is a network interface of a Linode config. Interfaces are attached to eth0,
+// eth1 and eth2 in order. ID, Active and VPCID are only ever set by the API.
+type ConfigInterface struct {
+	ID          uint                 `json:"id,omitempty"`
+	Purpose     InterfacePurpose     `json:"purpose"`
+	Label       string               `json:"label,omitempty"`
+	IPAMAddress string               `json:"ipam_address,omitempty"`
+	Primary     bool                 `json:"primary,omitempty"`
+	Active      bool                 `json:"active,omitempty"`
+	VPCID       uint                 `json:"vpc_id,omitempty"`
+	SubnetID    uint                 `json:"subnet_id,omitempty"`
+	IPv4        *ConfigInterfaceIPv4 `json:"ipv4,omitempty"`
+	IPRanges    []string             `json:"ip_ranges,omitempty"`
+}
+
+// A LinodeConfig represents a boot configuration of a Linode instance.
+type LinodeConfig struct {
+	ID          uint              `json:"id"`
+	Label       string            `json:"label"`
+	Comments    string            `json:"comments"`
+	Kernel      string            `json:"kernel"`
+	MemoryLimit uint              `json:"memory_limit"`
+	RootDevice  string            `json:"root_device"`
+	RunLevel    RunLevel          `json:"run_level"`
+	VirtMode    VirtMode          `json:"virt_mode"`
+	Devices     ConfigDevices     `json:"devices"`
+	Helpers     ConfigHelpers     `json:"helpers"`
+	Interfaces  []ConfigInterface `json:"interfaces"`
+	Created     Time              `json:"created"`
+	Updated     Time              `json:"updated"`
+}
+
+// CreateConfigRequest is a parameter struct for creating Linode configs. A config without
+// interfaces gets a single public interface.
+type CreateConfigRequest struct {
+	LinodeID    uint              `json:"-"`
+	Label       string            `json:"label"`
+	Comments    string            `json:"comments,omitempty"`
+	Kernel      string            `json:"kernel,omitempty"`
+	MemoryLimit uint              `json:"memory_limit,omitempty"`
+	RootDevice  string            `json:"root_device,omitempty"`
+	RunLevel    RunLevel          `json:"run_level,omitempty"`
+	VirtMode    VirtMode          `json:"virt_mode,omitempty"`
+	Devices     ConfigDevices     `json:"devices"`
+	Helpers     *ConfigHelpers    `json:"helpers,omitempty"`
+	Interfaces  []ConfigInterface `json:"interfaces,omitempty"`
+}
+
+// UpdateConfigRequest is a parameter struct for updating an existing Linode config. Interfaces
+// replace the config's existing interfaces when set, and are left alone when nil. InterfaceList()
+// with no interfaces puts the config back to a single public interface.
+type UpdateConfigRequest struct {
+	ID          uint               `json:"-"`
+	LinodeID    uint               `json:"-"`
+	Label       string             `json:"label,omitempty"`
+	Comments    string             `json:"comments,omitempty"`
+	Kernel      string             `json:"kernel,omitempty"`
+	MemoryLimit uint               `json:"memory_limit,omitempty"`
+	RootDevice  string             `json:"root_device,omitempty"`
+	RunLevel    RunLevel           `json:"run_level,omitempty"`
+	VirtMode    VirtMode           `json:"virt_mode,omitempty"`
+	Devices     *ConfigDevices     `json:"devices,omitempty"`
+	Helpers     *ConfigHelpers     `json:"helpers,omitempty"`
+	Interfaces  *[]ConfigInterface `json:"interfaces,omitempty"`
+}
+
+// UpdateConfigInterfaceRequest is a parameter struct for updating an existing configuration
+// interface. Only the primary flag and the VPC settings of an interface can change.
+type UpdateConfigInterfaceRequest struct {
+	ID       uint                 `json:"-"`
+	LinodeID uint                 `json:"-"`
+	ConfigID uint                 `json:"-"`
+	Primary  bool                 `json:"primary"`
+	IPv4     *ConfigInterfaceIPv4 `json:"ipv4,omitempty"`
+	IPRanges []string             `json:"ip_ranges,omitempty"`
+}
+
+// A Configer works with Linode configs and their network interfaces.
+type Configer interface {
+	ListConfigs(linodeID uint) ([]LinodeConfig, error)
+	ViewConfig(linodeID, configID uint) (LinodeConfig, error)
+	CreateConfig(req CreateConfigRequest) (LinodeConfig, error)
+	UpdateConfig(req UpdateConfigRequest) (LinodeConfig, error)
+	DeleteConfig(linodeID, configID uint) error
+	ListConfigInterfaces(linodeID, configID uint) ([]ConfigInterface, error)
+	ViewConfigInterface(linodeID, configID, interfaceID uint) (ConfigInterface, error)
+	AddConfigInterface(linodeID, configID uint, iface ConfigInterface) (ConfigInterface, error)
+	UpdateConfigInterface(req UpdateConfigInterfaceRequest) (ConfigInterface, error)
+	DeleteConfigInterface(linodeID, configID, interfaceID uint) error
+	ReorderConfigInterfaces(linodeID, configID uint, interfaceIDs []uint) error
+}
+
+// NewPublicInterface returns an interface attaching a Linode to the public internet.
+func NewPublicInterface() ConfigInterface {
+	return ConfigInterface{Purpose: InterfacePurposePublic}
+}
+
+// NewVLANInterface returns an interface attaching a Linode to the VLAN with the given label. The
+// IPAM address, in CIDR notation, is configured on the interface by Network Helper and can be left
+// empty to configure the interface by hand.
+func NewVLANInterface(label, ipamAddress string) ConfigInterface {
+	return ConfigInterface{Purpose: InterfacePurposeVLAN, Label: label, IPAMAddress: ipamAddress}
+}
+
+// NewVPCInterface returns an interface attaching a Linode to a VPC subnet. An empty address is
+// assigned from the subnet automatically, and nat gives the interface a public address translated
+// 1:1 to its VPC address.
+func NewVPCInterface(subnetID uint, address string, nat bool) ConfigInterface {
+	iface := ConfigInterface{Purpose: InterfacePurposeVPC, SubnetID: subnetID}
+	if address != "" || nat {
+		iface.IPv4 = &ConfigInterfaceIPv4{VPC: address}
+		if nat {
+			iface.IPv4.NAT1To1 = NAT1To1Any
+		}
+	}
+
+	return iface
+}
+
+// InterfaceList returns interfaces in the form UpdateConfigRequest takes them.
+func InterfaceList(ifaces ...ConfigInterface) *[]ConfigInterface {
+	if ifaces == nil {
+		ifaces = []ConfigInterface{}
+	}
+
+	return &ifaces
+}
+
+var vlanLabelPattern = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+
+// Validate checks a single interface for mistakes the API would otherwise reject after the fact.
+func (i ConfigInterface) Validate() error {
+	switch i.Purpose {
+	case InterfacePurposePublic:
+		if i.Label != "" || i.IPAMAddress != "" {
+			return errors.New("public interfaces can't have a label or IPAM address")
+		}
+	case InterfacePurposeVLAN:
+		if !vlanLabelPattern.MatchString(i.Label) {
+			return errors.Errorf("VLAN label %q must be 1-64 letters, digits, dashes or underscores", i.Label)
+		}
+
+		if i.IPAMAddress != "" {
+			if _, err := netip.ParsePrefix(i.IPAMAddress); err != nil {
+				return errors.Wrapf(err, "IPAM address %q for VLAN %s must be in CIDR notation", i.IPAMAddress, i.Label)
+			}
+		}
+
+		if i.Primary {
+			return errors.Errorf("VLAN interface %s can't be primary", i.Label)
+		}
+	case InterfacePurposeVPC:
+		if i.SubnetID == 0 {
+			return errors.New("VPC interfaces need a subnet")
+		}
+
+		if i.IPv4 != nil && i.IPv4.VPC != "" {
+			addr, err := netip.ParseAddr(i.IPv4.VPC)
+			if err != nil {
+				return errors.Wrapf(err, "VPC address %q isn't a valid IPv4 address", i.IPv4.VPC)
+			}
+
+			if !addr.Is4() {
+				return errors.Errorf("VPC address %q isn't an IPv4 address", i.IPv4.VPC)
+			}
+		}
+
+		if i.IPv4 != nil && i.IPv4.NAT1To1 != "" && i.IPv4.NAT1To1 != NAT1To1Any {
+			addr, err := netip.ParseAddr(i.IPv4.NAT1To1)
+			if err != nil {
+				return errors.Wrapf(err, "1:1 NAT address %q must be %q or an IPv4 address", i.IPv4.NAT1To1, NAT1To1Any)
+			}
+
+			if !addr.Is4() {
+				return errors.Errorf("1:1 NAT address %q must be %q or an IPv4 address", i.IPv4.NAT1To1, NAT1To1Any)
+			}
+		}
+
+		for _, r := range i.IPRanges {
+			if _, err := netip.ParsePrefix(r); err != nil {
+				return errors.Wrapf(err, "VPC range %q must be in CIDR notation", r)
+			}
+		}
+	default:
+		return errors.Errorf("unknown interface purpose %q", i.Purpose)
+	}
+
+	return nil
+}
+
+// ValidateInterfaces checks a config's interfaces against each other as well as individually. A
+// config can have at most MaxConfigInterfaces interfaces, at most one public and one VPC
+// interface, at most one primary interface, and can't attach to the same VLAN twice.
+func ValidateInterfaces(ifaces []ConfigInterface) error {
+	if len(ifaces) > MaxConfigInterfaces {
+		return errors.Errorf("a config can have at most %d interfaces, but got %d", MaxConfigInterfaces, len(ifaces))
+	}
+
+	var public, vpc, primary int
+	vlans := make(map[string]bool)
+	for n, iface := range ifaces {
+		if err := iface.Validate(); err != nil {
+			return errors.Wrapf(err, "invalid interface eth%d", n)
+		}
+
+		switch iface.Purpose {
+		case InterfacePurposePublic:
+			public++
+		case InterfacePurposeVPC:
+			vpc++
+		case InterfacePurposeVLAN:
+			if vlans[iface.Label] {
+				return errors.Errorf("VLAN %s is attached more than once", iface.Label)
+			}
+			vlans[iface.Label] = true
+		}
+
+		if iface.Primary {
+			primary++
+		}
+	}
+
+	if public > 1 || vpc > 1 {
+		return errors.New("a config can have at most one public and one VPC interface")
+	}
+
+	if primary > 1 {
+		return errors.New("a config can have at most one primary interface")
+	}
+
+	return nil
+}
+
+// ValidateRunLevel validates whether or not a test string is a RunLevel enum.
+func ValidateRunLevel(test string) bool {
+	switch RunLevel(test) {
+	case RunLevelDefault, RunLevelSingle, RunLevelBinBash:
+		return true
+	default:
+		return false
+	}
+}
+
+// ValidateVirtMode validates whether or not a test string is a VirtMode enum.
+func ValidateVirtMode(test string) bool {
+	switch VirtMode(test) {
+	case VirtModeParavirt, VirtModeFullvirt:
+		return true
+	default:
+		return false
+	}
+}
+
+// ValidateInterfacePurpose validates whether or not a test string is an InterfacePurpose enum.
+func ValidateInterfacePurpose(test string) bool {
+	switch InterfacePurpose(test) {
+	case InterfacePurposePublic, InterfacePurposeVLAN, InterfacePurposeVPC:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,220 @@
+package lingo
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// A ConfigClient implements the Configer interface and provides all of the functionality for
+// managing Linode configs and their network interfaces.
+type ConfigClient struct {
+	api APIClient
+}
+
+// NewConfigClient returns a new ConfigClient given a valid APIClient.
+func NewConfigClient(api APIClient) ConfigClient {
+	return ConfigClient{api: api}
+}
+
+// ListConfigs retrieves all of the configs of a Linode instance.
+func (c ConfigClient) ListConfigs(linodeID uint) ([]LinodeConfig, error) {
+	data, err := c.api.Get(fmt.Sprintf("linode/instances/%d/configs", linodeID))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make request for ListConfigs")
+	}
+
+	var results Results
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, errors.Wrap(err, "failed to decode ListConfigs response")
+	}
+
+	var configs []LinodeConfig
+	if err := json.Unmarshal(results.Data, &configs); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal ListConfigs data")
+	}
+
+	return configs, nil
+}
+
+// ViewConfig retrieves a single config of a Linode instance.
+func (c ConfigClient) ViewConfig(linodeID, configID uint) (LinodeConfig, error) {
+	var config LinodeConfig
+	data, err := c.api.Get(fmt.Sprintf("linode/instances/%d/configs/%d", linodeID, configID))
+	if err != nil {
+		return config, errors.Wrap(err, "failed to make request for ViewConfig")
+	}
+
+	if err := json.Unmarshal(data, &config); err != nil {
+		return config, errors.Wrap(err, "failed to decode ViewConfig response")
+	}
+
+	return config, nil
+}
+
+// CreateConfig creates a new config for a Linode instance.
+func (c ConfigClient) CreateConfig(req CreateConfigRequest) (LinodeConfig, error) {
+	var config LinodeConfig
+	if err := ValidateInterfaces(req.Interfaces); err != nil {
+		return config, errors.Wrap(err, "invalid request for CreateConfig")
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return config, errors.Wrap(err, "failed to marshal request for CreateConfig")
+	}
+
+	data, err := c.api.Post(fmt.Sprintf("linode/instances/%d/configs", req.LinodeID), payload)
+	if err != nil {
+		return config, errors.Wrap(err, "failed to make request for CreateConfig")
+	}
+
+	if err := json.Unmarshal(data, &config); err != nil {
+		return config, errors.Wrap(err, "failed to decode CreateConfig response")
+	}
+
+	return config, nil
+}
+
+// UpdateConfig updates an existing config of a Linode instance.
+func (c ConfigClient) UpdateConfig(req UpdateConfigRequest) (LinodeConfig, error) {
+	var config LinodeConfig
+	if req.Interfaces != nil {
+		if err := ValidateInterfaces(*req.Interfaces); err != nil {
+			return config, errors.Wrap(err, "invalid request for UpdateConfig")
+		}
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return config, errors.Wrap(err, "failed to marshal request for UpdateConfig")
+	}
+
+	data, err := c.api.Put(fmt.Sprintf("linode/instances/%d/configs/%d", req.LinodeID, req.ID), payload)
+	if err != nil {
+		return config, errors.Wrap(err, "failed to make request for UpdateConfig")
+	}
+
+	if err := json.Unmarshal(data, &config); err != nil {
+		return config, errors.Wrap(err, "failed to decode UpdateConfig response")
+	}
+
+	return config, nil
+}
+
+// DeleteConfig deletes a config of a Linode instance.
+func (c ConfigClient) DeleteConfig(linodeID, configID uint) error {
+	if _, err := c.api.Delete(fmt.Sprintf("linode/instances/%d/configs/%d", linodeID, configID)); err != nil {
+		return errors.Wrap(err, "failed to make request for DeleteConfig")
+	}
+
+	return nil
+}
+
+// ListConfigInterfaces retrieves the interfaces of a Linode config in eth0, eth1, eth2 order.
+func (c ConfigClient) ListConfigInterfaces(linodeID, configID uint) ([]ConfigInterface, error) {
+	data, err := c.api.Get(fmt.Sprintf("linode/instances/%d/configs/%d/interfaces", linodeID, configID))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make request for ListConfigInterfaces")
+	}
+
+	// Unlike most lists, interfaces come back as a bare array.
+	var ifaces []ConfigInterface
+	if err := json.Unmarshal(data, &ifaces); err != nil {
+		return nil, errors.Wrap(err, "failed to decode ListConfigInterfaces response")
+	}
+
+	return ifaces, nil
+}
+
+// ViewConfigInterface retrieves a single interface of a Linode config.
+func (c ConfigClient) ViewConfigInterface(linodeID, configID, interfaceID uint) (ConfigInterface, error) {
+	var iface ConfigInterface
+	data, err := c.api.Get(fmt.Sprintf("linode/instances/%d/configs/%d/interfaces/%d", linodeID, configID, interfaceID))
+	if err != nil {
+		return iface, errors.Wrap(err, "failed to make request for ViewConfigInterface")
+	}
+
+	if err := json.Unmarshal(data, &iface); err != nil {
+		return iface, errors.Wrap(err, "failed to decode ViewConfigInterface response")
+	}
+
+	return iface, nil
+}
+
+// AddConfigInterface appends an interface to a Linode config. The Linode has to be rebooted into
+// the config before the interface becomes active.
+func (c ConfigClient) AddConfigInterface(linodeID, configID uint, iface ConfigInterface) (ConfigInterface, error) {
+	var added ConfigInterface
+	if err := iface.Validate(); err != nil {
+		return added, errors.Wrap(err, "invalid request for AddConfigInterface")
+	}
+
+	payload, err := json.Marshal(iface)
+	if err != nil {
+		return added, errors.Wrap(err, "failed to marshal request for AddConfigInterface")
+	}
+
+	data, err := c.api.Post(fmt.Sprintf("linode/instances/%d/configs/%d/interfaces", linodeID, configID), payload)
+	if err != nil {
+		return added, errors.Wrap(err, "failed to make request for AddConfigInterface")
+	}
+
+	if err := json.Unmarshal(data, &added); err != nil {
+		return added, errors.Wrap(err, "failed to decode AddConfigInterface response")
+	}
+
+	return added, nil
+}
+
+// UpdateConfigInterface updates the primary flag or VPC settings of an existing interface.
+func (c ConfigClient) UpdateConfigInterface(req UpdateConfigInterfaceRequest) (ConfigInterface, error) {
+	var iface ConfigInterface
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return iface, errors.Wrap(err, "failed to marshal request for UpdateConfigInterface")
+	}
+
+	url := fmt.Sprintf("linode/instances/%d/configs/%d/interfaces/%d", req.LinodeID, req.ConfigID, req.ID)
+	data, err := c.api.Put(url, payload)
+	if err != nil {
+		return iface, errors.Wrap(err, "failed to make request for UpdateConfigInterface")
+	}
+
+	if err := json.Unmarshal(data, &iface); err != nil {
+		return iface, errors.Wrap(err, "failed to decode UpdateConfigInterface response")
+	}
+
+	return iface, nil
+}
+
+// DeleteConfigInterface removes an interface from a Linode config. The interfaces after it move
+// up to fill the gap.
+func (c ConfigClient) DeleteConfigInterface(linodeID, configID, interfaceID uint) error {
+	url := fmt.Sprintf("linode/instances/%d/configs/%d/interfaces/%d", linodeID, configID, interfaceID)
+	if _, err := c.api.Delete(url); err != nil {
+		return errors.Wrap(err, "failed to make request for DeleteConfigInterface")
+	}
+
+	return nil
+}
+
+// ReorderConfigInterfaces changes which interface is attached to eth0, eth1 and eth2. Every
+// interface of the config has to be listed.
+func (c ConfigClient) ReorderConfigInterfaces(linodeID, configID uint, interfaceIDs []uint) error {
+	order := struct {
+		IDs []uint `json:"ids"`
+	}{interfaceIDs}
+
+	payload, err := json.Marshal(order)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request for ReorderConfigInterfaces")
+	}
+
+	if _, err := c.api.Post(fmt.Sprintf("linode/instances/%d/configs/%d/interfaces/order", linodeID, configID), payload); err != nil {
+		return errors.Wrap(err, "failed to make request for ReorderConfigInterfaces")
+	}
+
+	return nil
+}
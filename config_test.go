@@ -0,0 +1,86 @@
+package lingo_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/eriktate/lingo"
+)
+
+func Test_ValidateInterfaces(t *testing.T) {
+	primaryVLAN := lingo.NewVLANInterface("backend", "")
+	primaryVLAN.Primary = true
+
+	cases := []struct {
+		name   string
+		ifaces []lingo.ConfigInterface
+		valid  bool
+	}{
+		{"empty", nil, true},
+		{"public, vpc and vlan", []lingo.ConfigInterface{
+			lingo.NewPublicInterface(),
+			lingo.NewVPCInterface(1, "", true),
+			lingo.NewVLANInterface("backend", "10.0.0.1/24"),
+		}, true},
+		{"too many", []lingo.ConfigInterface{
+			lingo.NewPublicInterface(),
+			lingo.NewVLANInterface("a", ""),
+			lingo.NewVLANInterface("b", ""),
+			lingo.NewVLANInterface("c", ""),
+		}, false},
+		{"two public", []lingo.ConfigInterface{lingo.NewPublicInterface(), lingo.NewPublicInterface()}, false},
+		{"two vpc", []lingo.ConfigInterface{lingo.NewVPCInterface(1, "", false), lingo.NewVPCInterface(2, "", false)}, false},
+		{"same vlan twice", []lingo.ConfigInterface{lingo.NewVLANInterface("a", ""), lingo.NewVLANInterface("a", "")}, false},
+		{"ipam without prefix", []lingo.ConfigInterface{lingo.NewVLANInterface("a", "10.0.0.1")}, false},
+		{"bad vlan label", []lingo.ConfigInterface{lingo.NewVLANInterface("no spaces", "")}, false},
+		{"primary vlan", []lingo.ConfigInterface{primaryVLAN}, false},
+		{"vpc without subnet", []lingo.ConfigInterface{lingo.NewVPCInterface(0, "", false)}, false},
+		{"bad vpc address", []lingo.ConfigInterface{lingo.NewVPCInterface(1, "10.0.0", false)}, false},
+		{"ipv6 vpc address", []lingo.ConfigInterface{lingo.NewVPCInterface(1, "fd00::10", false)}, false},
+		{"ipv4 nat address", []lingo.ConfigInterface{{Purpose: lingo.InterfacePurposeVPC, SubnetID: 1, IPv4: &lingo.ConfigInterfaceIPv4{NAT1To1: "203.0.113.5"}}}, true},
+		{"ipv6 nat address", []lingo.ConfigInterface{{Purpose: lingo.InterfacePurposeVPC, SubnetID: 1, IPv4: &lingo.ConfigInterfaceIPv4{NAT1To1: "2001:db8::5"}}}, false},
+		{"unknown purpose", []lingo.ConfigInterface{{Purpose: "bridge"}}, false},
+	}
+
+	for _, c := range cases {
+		err := lingo.ValidateInterfaces(c.ifaces)
+		if c.valid && err != nil {
+			t.Fatalf("%s: expected interfaces to be valid, but got %s", c.name, err)
+		}
+
+		if !c.valid && err == nil {
+			t.Fatalf("%s: expected interfaces to be rejected", c.name)
+		}
+	}
+
+	nat := lingo.NewVPCInterface(1, "", true)
+	if nat.IPv4 == nil || nat.IPv4.NAT1To1 != lingo.NAT1To1Any || nat.IPv4.VPC != "" {
+		t.Fatalf("Expected a NATed VPC interface with an automatic address, but got %+v", nat.IPv4)
+	}
+}
+
+func Test_UpdateConfigInterfaces(t *testing.T) {
+	data, err := json.Marshal(lingo.UpdateConfigRequest{ID: 1, LinodeID: 2, Interfaces: lingo.InterfaceList()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(string(data), `"interfaces":[]`) {
+		t.Fatalf("Expected clearing interfaces to send an empty list, but got %s", data)
+	}
+
+	data, err = json.Marshal(lingo.UpdateConfigRequest{ID: 1, LinodeID: 2, Label: "boot"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Contains(string(data), "interfaces") {
+		t.Fatalf("Expected interfaces to be left out when not set, but got %s", data)
+	}
+
+	bad := lingo.UpdateConfigRequest{ID: 1, LinodeID: 2, Interfaces: lingo.InterfaceList(lingo.NewPublicInterface(), lingo.NewPublicInterface())}
+	if _, err := lingo.NewConfigClient(lingo.NewAPIClientWithURI("", "http://127.0.0.1:0/")).UpdateConfig(bad); err == nil || !strings.Contains(err.Error(), "invalid request") {
+		t.Fatalf("Expected invalid interfaces to be rejected before the request, but got %v", err)
+	}
+}
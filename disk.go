@@ -40,7 +40,7 @@ type CreateDiskRequest struct {
 	LinodeID        uint            `json:"-"`
 	Size            uint            `json:"size"`
 	Image           string          `json:"image,omitempty"`
-	RootPass        string          `json:"root_pass,omitempty"`
+	RootPass        Secret          `json:"root_pass,omitempty"`
 	AuthorizedKeys  []string        `json:"authorized_keys,omitempty"`
 	Label           string          `json:"label,omitempty"`
 	FileSystem      FileSystem      `json:"filesystem,omitempty"`
@@ -64,7 +64,7 @@ type Disker interface {
 	CreateDisk(req CreateDiskRequest) (Disk, error)
 	UpdateDisk(req UpdateDiskRequest) (Disk, error)
 	DeleteDisk(linodeID, diskID uint) error
-	ResetDiskRootPassword(linodeID, diskID uint, password string) (Disk, error)
+	ResetDiskRootPassword(linodeID, diskID uint, password Secret) (Disk, error)
 	ResizeDisk(linodeID, diskID, size uint) (Disk, error)
 }
 
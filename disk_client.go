@@ -103,15 +103,20 @@ func (c DiskClient) DeleteDisk(linodeID, diskID uint) error {
 	return nil
 }
 
-// ResetDiskRootPassword resets the root password on the specified Disk.
-func (c DiskClient) ResetDiskRootPassword(req UpdateDiskRequest) (Disk, error) {
+// ResetDiskRootPassword resets the root password on the specified Disk. The Linode has to be shut
+// down first.
+func (c DiskClient) ResetDiskRootPassword(linodeID, diskID uint, password Secret) (Disk, error) {
 	var disk Disk
+	req := struct {
+		Password Secret `json:"password"`
+	}{password}
+
 	payload, err := json.Marshal(req)
 	if err != nil {
 		return disk, errors.Wrap(err, "failed to marshal request for ResetDiskRootPassword")
 	}
 
-	data, err := c.api.Post(fmt.Sprintf("linode/instances/%d/disks/%d/password", req.LinodeID, req.ID), payload)
+	data, err := c.api.Post(fmt.Sprintf("linode/instances/%d/disks/%d/password", linodeID, diskID), payload)
 	if err != nil {
 		return disk, errors.Wrap(err, "failed to make request for ResetDiskRootPassword")
 	}
@@ -16,11 +16,11 @@ func Test_Disks(t *testing.T) {
 	linodeClient := lingo.NewLinodeClient(api)
 
 	createLinode := lingo.CreateLinodeRequest{
-		Region:   "us-east-1a",
-		Type:     "g5-standard-2",
-		Image:    "linode/debian9",
-		RootPass: "test123",
-		Booted:   true,
+		Region:           "us-east-1a",
+		Type:             "g5-standard-2",
+		Image:            "linode/debian9",
+		GenerateRootPass: true,
+		Booted:           true,
 	}
 
 	// log.Println("Creating linode to add disks...")
@@ -40,7 +40,7 @@ func Test_Disks(t *testing.T) {
 		LinodeID: testLinode.ID,
 		Size:     512,
 		Image:    "linode/debian9",
-		RootPass: "test321",
+		RootPass: "test321-Lingo!",
 	}
 
 	waitUntilRunning(linodeClient, testLinode.ID)
@@ -88,11 +88,11 @@ func Test_ResizeDisk(t *testing.T) {
 	linodeClient := lingo.NewLinodeClient(api)
 
 	createLinode := lingo.CreateLinodeRequest{
-		Region:   "us-east-1a",
-		Type:     "g5-standard-2",
-		Image:    "linode/debian9",
-		RootPass: "test123",
-		Booted:   true,
+		Region:           "us-east-1a",
+		Type:             "g5-standard-2",
+		Image:            "linode/debian9",
+		GenerateRootPass: true,
+		Booted:           true,
 	}
 
 	// log.Println("Creating linode to add disks...")
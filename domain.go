@@ -35,6 +35,26 @@ type Domain struct {
 	ExpireSec   uint         `json:"expire_sec,omitempty"`
 	RefreshSec  uint         `json:"refresh_sec,omitempty"`
 	SOA         string       `json:"soa_email,omitempty"`
+	Tags        []string     `json:"tags,omitempty"`
+}
+
+// An UpdateDomainRequest is a parameter struct for specifying how to update an existing Domain.
+// Only fields that are set are changed. Tags are left alone unless set, and TagList() with no tags
+// clears them.
+type UpdateDomainRequest struct {
+	ID          uint         `json:"-"`
+	Domain      string       `json:"domain,omitempty"`
+	Type        DomainType   `json:"type,omitempty"`
+	Status      DomainStatus `json:"status,omitempty"`
+	Description string       `json:"description,omitempty"`
+	TTLSec      uint         `json:"ttl_sec,omitempty"`
+	RetrySec    uint         `json:"retry_sec,omitempty"`
+	MasterIPs   []string     `json:"master_ips,omitempty"`
+	AxfrIPs     []string     `json:"axfr_ips,omitempty"`
+	ExpireSec   uint         `json:"expire_sec,omitempty"`
+	RefreshSec  uint         `json:"refresh_sec,omitempty"`
+	SOA         string       `json:"soa_email,omitempty"`
+	Tags        *[]string    `json:"tags,omitempty"`
 }
 
 // A DomainRecordType is an enumeration of possible Linode Domain Record types.
@@ -61,6 +81,7 @@ type DomainRecord struct {
 	Priority uint8            `json:"priority"`
 	Type     DomainRecordType `json:"type"`
 	Port     uint             `json:"port"`
+	Weight   uint             `json:"weight"`
 	Service  string           `json:"service,omitempty"`
 	Protocol string           `json:"protocol,omitempty"`
 	TTLSec   uint             `json:"ttl_sec"`
@@ -70,12 +91,14 @@ type DomainRecord struct {
 // A Domainer works with Linode Domains and Domain Records.
 type Domainer interface {
 	ListDomains() ([]Domain, error)
+	ListDomainsByTag(tag string) ([]Domain, error)
 	ViewDomain(id uint) (Domain, error)
 	CreateDomain(domain Domain) (Domain, error)
-	UpdateDomain(domain Domain) (Domain, error)
+	UpdateDomain(req UpdateDomainRequest) (Domain, error)
 	DeleteDomain(id uint) error
+	CloneDomain(id uint, domain string) (Domain, error)
 
-	ListDomainRecord(domainID uint) ([]DomainRecord, error)
+	ListDomainRecords(domainID uint) ([]DomainRecord, error)
 	ViewDomainRecord(domainID, recordID uint) (DomainRecord, error)
 	CreateDomainRecord(domainID uint, record DomainRecord) (DomainRecord, error)
 	UpdateDomainRecord(domainID uint, record DomainRecord) (DomainRecord, error)
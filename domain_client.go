@@ -39,6 +39,26 @@ func (c DomainClient) ListDomains() ([]Domain, error) {
 	return domains, nil
 }
 
+// ListDomainsByTag retrieves a slice of the Domains carrying the given tag.
+func (c DomainClient) ListDomainsByTag(tag string) ([]Domain, error) {
+	data, err := c.api.GetFiltered("domains", TagFilter(tag))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make request for ListDomainsByTag")
+	}
+
+	var results Results
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, errors.Wrap(err, "failed to decode ListDomainsByTag response")
+	}
+
+	var domains []Domain
+	if err := json.Unmarshal(results.Data, &domains); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal ListDomainsByTag data")
+	}
+
+	return domains, nil
+}
+
 // ViewDomain retrieves a specific Linode Domain.
 func (c DomainClient) ViewDomain(id uint) (Domain, error) {
 	var domain Domain
@@ -77,15 +97,15 @@ func (c DomainClient) CreateDomain(domain Domain) (Domain, error) {
 }
 
 // UpdateDomain updates a specific Domain in a Linode account if it exists.
-func (c DomainClient) UpdateDomain(domain Domain) (Domain, error) {
+func (c DomainClient) UpdateDomain(req UpdateDomainRequest) (Domain, error) {
 	var updated Domain
 
-	payload, err := json.Marshal(&domain)
+	payload, err := json.Marshal(&req)
 	if err != nil {
 		return updated, errors.Wrap(err, "failed to marshal request for UpdateDomain")
 	}
 
-	data, err := c.api.Put(fmt.Sprintf("domains/%d", domain.ID), payload)
+	data, err := c.api.Put(fmt.Sprintf("domains/%d", req.ID), payload)
 	if err != nil {
 		return updated, errors.Wrap(err, "failed to make request for UpdateDomain")
 	}
@@ -108,6 +128,27 @@ func (c DomainClient) DeleteDomain(id uint) error {
 	return nil
 }
 
+// CloneDomain copies an existing Domain and all of its Domain Records under a new domain name.
+func (c DomainClient) CloneDomain(id uint, domain string) (Domain, error) {
+	var cloned Domain
+
+	payload, err := json.Marshal(map[string]string{"domain": domain})
+	if err != nil {
+		return cloned, errors.Wrap(err, "failed to marshal request for CloneDomain")
+	}
+
+	data, err := c.api.Post(fmt.Sprintf("domains/%d/clone", id), payload)
+	if err != nil {
+		return cloned, errors.Wrap(err, "failed to make request for CloneDomain")
+	}
+
+	if err := json.Unmarshal(data, &cloned); err != nil {
+		return cloned, errors.Wrap(err, "failed to decode CloneDomain response")
+	}
+
+	return cloned, nil
+}
+
 // ListDomainRecords retrieves a slice of Domain Records available within the specified Domain.
 func (c DomainClient) ListDomainRecords(domainID uint) ([]DomainRecord, error) {
 	data, err := c.api.Get(fmt.Sprintf("domains/%d/records", domainID))
@@ -149,6 +190,10 @@ func (c DomainClient) ViewDomainRecord(domainID, recordID uint) (DomainRecord, e
 func (c DomainClient) CreateDomainRecord(domainID uint, record DomainRecord) (DomainRecord, error) {
 	var created DomainRecord
 
+	if err := record.Validate(); err != nil {
+		return created, errors.Wrap(err, "invalid record for CreateDomainRecord")
+	}
+
 	payload, err := json.Marshal(&record)
 	if err != nil {
 		return created, errors.Wrap(err, "failed to marshal request for CreateDomainRecord")
@@ -170,6 +215,10 @@ func (c DomainClient) CreateDomainRecord(domainID uint, record DomainRecord) (Do
 func (c DomainClient) UpdateDomainRecord(domainID uint, record DomainRecord) (DomainRecord, error) {
 	var updated DomainRecord
 
+	if err := record.Validate(); err != nil {
+		return updated, errors.Wrap(err, "invalid record for UpdateDomainRecord")
+	}
+
 	payload, err := json.Marshal(&record)
 	if err != nil {
 		return updated, errors.Wrap(err, "failed to marshal request for UpdateDomainRecord")
@@ -195,3 +244,51 @@ func (c DomainClient) DeleteDomainRecord(domainID, recordID uint) error {
 
 	return nil
 }
+
+// ExportZone retrieves a Domain along with all of its Domain Records as a Zone, ready to be written
+// out as a zone file.
+func (c DomainClient) ExportZone(domainID uint) (Zone, error) {
+	var zone Zone
+
+	domain, err := c.ViewDomain(domainID)
+	if err != nil {
+		return zone, err
+	}
+
+	records, err := c.ListDomainRecords(domainID)
+	if err != nil {
+		return zone, err
+	}
+
+	zone.Domain = domain
+	zone.Records = records
+	return zone, nil
+}
+
+// ImportZone creates a new Domain from a Zone and then creates each of its Domain Records. If a
+// record fails to import, the Domain is still returned so it can be fixed up or deleted.
+func (c DomainClient) ImportZone(zone Zone) (Domain, error) {
+	domain, err := c.CreateDomain(zone.Domain)
+	if err != nil {
+		return domain, err
+	}
+
+	for _, record := range zone.Records {
+		if _, err := c.CreateDomainRecord(domain.ID, record); err != nil {
+			return domain, errors.Wrapf(err, "failed to import %s record %q", record.Type, record.Name)
+		}
+	}
+
+	return domain, nil
+}
+
+// ImportAXFR transfers a zone from a remote master nameserver, given as host:port, and creates it
+// as a new master Domain on Linode along with all of its records.
+func (c DomainClient) ImportAXFR(domain, nameserver string) (Domain, error) {
+	zone, err := TransferZone(nameserver, domain)
+	if err != nil {
+		return Domain{}, errors.Wrapf(err, "failed to transfer %s from %s", domain, nameserver)
+	}
+
+	return c.ImportZone(zone)
+}
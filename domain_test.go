@@ -39,8 +39,7 @@ func Test_CRUDDomain(t *testing.T) {
 		t.Fatalf("Failed to create domain 2: %s", err)
 	}
 
-	updateDomain := domain1
-	updateDomain.Description = "UPDATED"
+	updateDomain := lingo.UpdateDomainRequest{ID: domain1.ID, Description: "UPDATED"}
 	if _, err := client.UpdateDomain(updateDomain); err != nil {
 		t.Fatalf("Failed to update domain: %s", err)
 	}
@@ -137,3 +136,97 @@ func Test_CRUDDomainRecord(t *testing.T) {
 		t.Fatalf("Failed to cleanup domain: %s", err)
 	}
 }
+
+func Test_DomainRecordValidate(t *testing.T) {
+	valid := []lingo.DomainRecord{
+		lingo.NewARecord("www", "192.0.2.1"),
+		lingo.NewARecord("", "192.0.2.1").WithTTL(300),
+		lingo.NewARecord("*.apps", "192.0.2.1"),
+		lingo.NewAAAARecord("www", "2001:db8::1"),
+		lingo.NewCNAMERecord("blog", "example.net."),
+		lingo.NewMXRecord("", "mail.example.com", 10),
+		lingo.NewTXTRecord("_dmarc", "v=DMARC1; p=none"),
+		lingo.NewNSRecord("sub", "ns1.example.net"),
+		lingo.NewPTRRecord("1", "host.example.com"),
+		lingo.NewSRVRecord("sip", "tcp", "sip.example.com", 10, 20, 5060),
+		lingo.NewSRVRecord("_xmpp-client", "_tcp", "chat.example.com", 0, 0, 5222),
+		lingo.NewSRVRecord("imap", "tcp", ".", 0, 0, 0),
+		lingo.NewCNAMERecord("selector1._domainkey", "selector1-contoso-com._domainkey.contoso.onmicrosoft.com."),
+		lingo.NewCAARecord("", "issue", "letsencrypt.org"),
+		lingo.NewCAARecord("", "issuewild", ";"),
+		lingo.NewCAARecord("", "iodef", "mailto:security@example.com"),
+	}
+
+	for _, record := range valid {
+		if err := record.Validate(); err != nil {
+			t.Fatalf("Expected %+v to be valid, but got: %s", record, err)
+		}
+	}
+
+	invalid := map[string]lingo.DomainRecord{
+		"unknown type":     {Type: "SPF", Target: "v=spf1"},
+		"A with IPv6":      lingo.NewARecord("www", "2001:db8::1"),
+		"A with hostname":  lingo.NewARecord("www", "example.com"),
+		"AAAA with IPv4":   lingo.NewAAAARecord("www", "192.0.2.1"),
+		"CNAME at apex":    lingo.NewCNAMERecord("", "example.net"),
+		"CNAME to IP":      lingo.NewCNAMERecord("www", "bad host"),
+		"MX to nothing":    lingo.NewMXRecord("", "", 10),
+		"NS bad label":     lingo.NewNSRecord("sub", "-ns.example.net"),
+		"empty TXT":        lingo.NewTXTRecord("", ""),
+		"SRV no service":   lingo.NewSRVRecord("", "tcp", "sip.example.com", 0, 0, 5060),
+		"SRV no port":      lingo.NewSRVRecord("sip", "tcp", "sip.example.com", 0, 0, 0),
+		"SRV bad protocol": lingo.NewSRVRecord("sip", "t.cp", "sip.example.com", 0, 0, 5060),
+		"CAA bad tag":      lingo.NewCAARecord("", "issuer", "letsencrypt.org"),
+		"CAA bad iodef":    lingo.NewCAARecord("", "iodef", "security@example.com"),
+		"TTL too low":      lingo.NewARecord("www", "192.0.2.1").WithTTL(5),
+		"TTL too high":     lingo.NewARecord("www", "192.0.2.1").WithTTL(lingo.MaxRecordTTL + 1),
+		"bad name":         lingo.NewARecord("bad name", "192.0.2.1"),
+	}
+
+	for name, record := range invalid {
+		if err := record.Validate(); err == nil {
+			t.Fatalf("Expected %s record to be invalid", name)
+		}
+	}
+}
+
+func Test_CloneDomain(t *testing.T) {
+	apiKey := os.Getenv("LINODE_API_KEY")
+	api := lingo.NewAPIClient(apiKey, nil)
+	client := lingo.NewDomainClient(api)
+
+	domain, err := client.CreateDomain(lingo.Domain{
+		Domain: "clonesource.io",
+		Type:   lingo.DomainTypeMaster,
+		SOA:    "test@otherdomain.com",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create domain: %s", err)
+	}
+
+	if _, err := client.CreateDomainRecord(domain.ID, lingo.NewARecord("www", "127.0.0.1")); err != nil {
+		t.Fatalf("Failed to create domain record: %s", err)
+	}
+
+	cloned, err := client.CloneDomain(domain.ID, "clonetarget.io")
+	if err != nil {
+		t.Fatalf("Failed to clone domain: %s", err)
+	}
+
+	records, err := client.ListDomainRecords(cloned.ID)
+	if err != nil {
+		t.Fatalf("Failed to list cloned domain records: %s", err)
+	}
+
+	if len(records) != 1 || records[0].Name != "www" {
+		t.Fatalf("Expected the cloned domain to have the www record, but got %+v", records)
+	}
+
+	if err := client.DeleteDomain(cloned.ID); err != nil {
+		t.Fatalf("Failed to delete cloned domain: %s", err)
+	}
+
+	if err := client.DeleteDomain(domain.ID); err != nil {
+		t.Fatalf("Failed to delete domain: %s", err)
+	}
+}
@@ -0,0 +1,78 @@
+package lingo
+
+// An EventAction is an enumeration of the actions an Event can record. Only the actions lingo
+// tracks are listed, but an Event can carry any action the API reports.
+type EventAction string
+
+// Enum values for EventAction.
+const (
+	EventActionLinodeBoot              = EventAction("linode_boot")
+	EventActionLinodeCreate            = EventAction("linode_create")
+	EventActionLinodeDelete            = EventAction("linode_delete")
+	EventActionLinodeMigrate           = EventAction("linode_migrate")
+	EventActionLinodeMigrateDatacenter = EventAction("linode_migrate_datacenter")
+	EventActionLinodeMutate            = EventAction("linode_mutate")
+	EventActionLinodeReboot            = EventAction("linode_reboot")
+	EventActionLinodeRebuild           = EventAction("linode_rebuild")
+	EventActionLinodeRescue            = EventAction("linode_rescue")
+	EventActionLinodeResize            = EventAction("linode_resize")
+	EventActionLinodeShutdown          = EventAction("linode_shutdown")
+)
+
+// An EventStatus is an enumeration of possible Event statuses.
+type EventStatus string
+
+// Enum values for EventStatus.
+const (
+	EventStatusScheduled    = EventStatus("scheduled")
+	EventStatusStarted      = EventStatus("started")
+	EventStatusFinished     = EventStatus("finished")
+	EventStatusFailed       = EventStatus("failed")
+	EventStatusNotification = EventStatus("notification")
+)
+
+// An EventEntity describes the resource an Event happened to.
+type EventEntity struct {
+	ID    uint   `json:"id"`
+	Label string `json:"label"`
+	Type  string `json:"type"`
+	URL   string `json:"url"`
+}
+
+// An Event represents something that happened on the account, such as a Linode booting or being
+// migrated. Long running actions report their progress through PercentComplete.
+type Event struct {
+	ID              uint         `json:"id"`
+	Action          EventAction  `json:"action"`
+	Status          EventStatus  `json:"status"`
+	Entity          *EventEntity `json:"entity"`
+	PercentComplete uint         `json:"percent_complete"`
+	TimeRemaining   string       `json:"time_remaining"`
+	Message         string       `json:"message"`
+	Username        string       `json:"username"`
+	Seen            bool         `json:"seen"`
+	Read            bool         `json:"read"`
+	Created         Time         `json:"created"`
+}
+
+// Done reports whether the Event's action has finished, successfully or not.
+func (e Event) Done() bool {
+	return e.Status == EventStatusFinished || e.Status == EventStatusFailed || e.Status == EventStatusNotification
+}
+
+// An Eventer works with account Events.
+type Eventer interface {
+	ListEvents() ([]Event, error)
+	ListEventsFiltered(filter Filter) ([]Event, error)
+	ViewEvent(id uint) (Event, error)
+}
+
+// ValidateEventStatus validates whether or not a test string is an EventStatus enum.
+func ValidateEventStatus(test string) bool {
+	switch EventStatus(test) {
+	case EventStatusScheduled, EventStatusStarted, EventStatusFinished, EventStatusFailed, EventStatusNotification:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,67 @@
+package lingo
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// An EventClient implements the Eventer interface and provides access to account Events.
+type EventClient struct {
+	api APIClient
+}
+
+// NewEventClient returns a new EventClient given a valid APIClient.
+func NewEventClient(api APIClient) EventClient {
+	return EventClient{api: api}
+}
+
+// ListEvents retrieves the most recent Events on the account, newest first.
+func (c EventClient) ListEvents() ([]Event, error) {
+	data, err := c.api.Get("account/events")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make request for ListEvents")
+	}
+
+	return decodeEvents(data, "ListEvents")
+}
+
+// ListEventsFiltered retrieves the most recent Events matching the Filter.
+func (c EventClient) ListEventsFiltered(filter Filter) ([]Event, error) {
+	data, err := c.api.GetFiltered("account/events", filter)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make request for ListEventsFiltered")
+	}
+
+	return decodeEvents(data, "ListEventsFiltered")
+}
+
+// ViewEvent retrieves a single Event.
+func (c EventClient) ViewEvent(id uint) (Event, error) {
+	var event Event
+	data, err := c.api.Get(fmt.Sprintf("account/events/%d", id))
+	if err != nil {
+		return event, errors.Wrap(err, "failed to make request for ViewEvent")
+	}
+
+	if err := json.Unmarshal(data, &event); err != nil {
+		return event, errors.Wrap(err, "failed to decode ViewEvent response")
+	}
+
+	return event, nil
+}
+
+func decodeEvents(data []byte, name string) ([]Event, error) {
+	var results Results
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode %s response", name)
+	}
+
+	var events []Event
+	if err := json.Unmarshal(results.Data, &events); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal %s data", name)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,301 @@
+package lingo
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Defaults for an IPFailover.
+const (
+	defaultFailoverInterface = "eth0"
+	defaultFailoverRouterID  = 51
+	failoverPrimaryPriority  = 150
+	failoverPriorityStep     = 10
+
+	// Priorities step down from the primary's, and have to stay above zero.
+	maxFailoverHosts = (failoverPrimaryPriority-1)/failoverPriorityStep + 1
+)
+
+// lelasticDCIDs maps regions to the datacenter IDs lelastic needs to announce shared addresses.
+var lelasticDCIDs = map[string]uint{
+	"us-central":   2,
+	"us-west":      3,
+	"us-southeast": 4,
+	"us-east":      6,
+	"eu-west":      7,
+	"ap-south":     9,
+	"eu-central":   10,
+	"ap-northeast": 11,
+	"ap-west":      14,
+	"ca-central":   15,
+	"ap-southeast": 16,
+	"us-iad":       17,
+	"us-ord":       18,
+	"fr-par":       19,
+	"us-sea":       20,
+	"br-gru":       21,
+	"nl-ams":       22,
+	"se-sto":       23,
+	"es-mad":       24,
+	"in-maa":       25,
+	"jp-osa":       26,
+	"it-mil":       27,
+	"us-mia":       28,
+	"id-cgk":       29,
+	"us-lax":       30,
+}
+
+// A FailoverHost is a Linode taking part in IP failover. PeerAddress is the address the other hosts
+// reach it on for VRRP, which is its private address when it has one.
+type FailoverHost struct {
+	Linode      Linode
+	Priority    uint8
+	Primary     bool
+	PeerAddress netip.Addr
+}
+
+// A Failover is a shared address set up between Linodes, which keepalived and lelastic configs can
+// be rendered for.
+type Failover struct {
+	Address   netip.Addr
+	Region    string
+	Hosts     []FailoverHost
+	Interface string
+	RouterID  uint8
+	DCID      uint
+}
+
+// An IPFailover shares an IPv4 address between Linodes in a region so it can fail over between
+// them. The first Linode given is the primary and the rest are backups in decreasing priority.
+type IPFailover struct {
+	linodes Linoder
+	network Networker
+
+	// Interface is the interface keepalived brings the shared address up on.
+	Interface string
+
+	// RouterID is the VRRP virtual router ID, which has to be unique among the failover groups on a
+	// network.
+	RouterID uint8
+
+	// DCID is the datacenter ID lelastic announces from. It's looked up from the region when zero.
+	DCID uint
+}
+
+// NewIPFailover returns a new IPFailover given valid Linoder and Networker implementations.
+func NewIPFailover(linodes Linoder, network Networker) IPFailover {
+	return IPFailover{
+		linodes:   linodes,
+		network:   network,
+		Interface: defaultFailoverInterface,
+		RouterID:  defaultFailoverRouterID,
+	}
+}
+
+// Setup shares the address with every Linode that doesn't already own it and checks that the
+// sharing took. Addresses already shared with a Linode stay shared.
+func (f IPFailover) Setup(address string, linodeIDs []uint) (Failover, error) {
+	failover := Failover{Interface: f.Interface, RouterID: f.RouterID, DCID: f.DCID}
+
+	addr, err := netip.ParseAddr(address)
+	if err != nil || !addr.Is4() {
+		return failover, errors.Errorf("%q isn't an IPv4 address", address)
+	}
+	failover.Address = addr
+
+	if len(linodeIDs) < 2 {
+		return failover, errors.New("failover needs at least two Linodes")
+	}
+
+	if len(linodeIDs) > maxFailoverHosts {
+		return failover, errors.Errorf("failover supports at most %d Linodes", maxFailoverHosts)
+	}
+
+	for i, id := range linodeIDs {
+		linode, err := f.linodes.ViewLinode(id)
+		if err != nil {
+			return failover, err
+		}
+
+		if failover.Region == "" {
+			failover.Region = linode.Region
+		} else if linode.Region != failover.Region {
+			return failover, errors.Errorf("Linode %s is in %s, but failover needs every Linode in %s", linode.Label, linode.Region, failover.Region)
+		}
+
+		peer, ok := peerAddress(linode, addr)
+		if !ok {
+			return failover, errors.Errorf("Linode %s has no IPv4 address for VRRP", linode.Label)
+		}
+
+		failover.Hosts = append(failover.Hosts, FailoverHost{
+			Linode:      linode,
+			Priority:    uint8(failoverPrimaryPriority - i*failoverPriorityStep),
+			Primary:     i == 0,
+			PeerAddress: peer,
+		})
+	}
+
+	if failover.DCID == 0 {
+		failover.DCID = lelasticDCIDs[failover.Region]
+	}
+
+	owner, err := f.network.ViewAddress(address)
+	if err != nil {
+		return failover, err
+	}
+
+	if _, ok := failover.host(owner.LinodeID); !ok {
+		return failover, errors.Errorf("%s belongs to Linode %d, which isn't part of the failover", address, owner.LinodeID)
+	}
+
+	for _, host := range failover.Hosts {
+		if host.Linode.ID == owner.LinodeID {
+			continue
+		}
+
+		if err := f.share(host.Linode, address); err != nil {
+			return failover, err
+		}
+	}
+
+	return failover, nil
+}
+
+// share adds the address to whatever is already shared with a Linode and checks it stuck.
+func (f IPFailover) share(linode Linode, address string) error {
+	shared, err := f.network.ViewSharing(linode.ID)
+	if err != nil {
+		return err
+	}
+
+	if sharesAddress(shared, address) {
+		return nil
+	}
+
+	ips := []string{address}
+	for _, s := range shared {
+		ips = append(ips, s.Address)
+	}
+
+	if err := f.network.ConfigureSharing(SharingRequest{LinodeID: linode.ID, IPs: ips}); err != nil {
+		return errors.Wrapf(err, "failed to share %s with %s", address, linode.Label)
+	}
+
+	shared, err = f.network.ViewSharing(linode.ID)
+	if err != nil {
+		return err
+	}
+
+	if !sharesAddress(shared, address) {
+		return errors.Errorf("%s was shared with %s, but isn't showing as shared", address, linode.Label)
+	}
+
+	return nil
+}
+
+// Keepalived renders the keepalived config for a single host. Hosts advertise to each other over
+// unicast, since Linode networks don't carry multicast.
+func (f Failover) Keepalived(linodeID uint) (string, error) {
+	self, ok := f.host(linodeID)
+	if !ok {
+		return "", errors.Errorf("Linode %d isn't part of the failover", linodeID)
+	}
+
+	state := "BACKUP"
+	if self.Primary {
+		state = "MASTER"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s (Linode %d)\n", self.Linode.Label, self.Linode.ID)
+	fmt.Fprintf(&b, "vrrp_instance lingo_%s {\n", strings.Replace(f.Address.String(), ".", "_", -1))
+	fmt.Fprintf(&b, "\tstate %s\n", state)
+	fmt.Fprintf(&b, "\tinterface %s\n", f.Interface)
+	fmt.Fprintf(&b, "\tvirtual_router_id %d\n", f.RouterID)
+	fmt.Fprintf(&b, "\tpriority %d\n", self.Priority)
+	b.WriteString("\tadvert_int 1\n")
+	fmt.Fprintf(&b, "\tunicast_src_ip %s\n", self.PeerAddress)
+	b.WriteString("\tunicast_peer {\n")
+	for _, peer := range f.Hosts {
+		if peer.Linode.ID != self.Linode.ID {
+			fmt.Fprintf(&b, "\t\t%s\n", peer.PeerAddress)
+		}
+	}
+	b.WriteString("\t}\n")
+	fmt.Fprintf(&b, "\tvirtual_ipaddress {\n\t\t%s/32\n\t}\n}\n", f.Address)
+
+	return b.String(), nil
+}
+
+// Lelastic renders a systemd unit running lelastic on a single host, announcing the shared address
+// as primary on the primary host and as secondary everywhere else.
+func (f Failover) Lelastic(linodeID uint) (string, error) {
+	self, ok := f.host(linodeID)
+	if !ok {
+		return "", errors.Errorf("Linode %d isn't part of the failover", linodeID)
+	}
+
+	if f.DCID == 0 {
+		return "", errors.Errorf("no lelastic datacenter ID is known for %s", f.Region)
+	}
+
+	role := "-secondary"
+	if self.Primary {
+		role = "-primary"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s (Linode %d)\n", self.Linode.Label, self.Linode.ID)
+	b.WriteString("[Unit]\nDescription=lelastic\nAfter=network-online.target\nWants=network-online.target\n\n")
+	fmt.Fprintf(&b, "[Service]\nType=simple\nExecStart=/usr/local/bin/lelastic -dcid %d %s\n", f.DCID, role)
+	b.WriteString("ExecReload=/bin/kill -s HUP $MAINPID\nRestart=on-failure\n\n")
+	b.WriteString("[Install]\nWantedBy=multi-user.target\n")
+
+	return b.String(), nil
+}
+
+func (f Failover) host(linodeID uint) (FailoverHost, bool) {
+	for _, host := range f.Hosts {
+		if host.Linode.ID == linodeID {
+			return host, true
+		}
+	}
+
+	return FailoverHost{}, false
+}
+
+// peerAddress picks the address a Linode should be reached on for VRRP, preferring private ones. The
+// shared address is never picked since it moves between hosts.
+func peerAddress(linode Linode, shared netip.Addr) (netip.Addr, bool) {
+	var public netip.Addr
+	for _, ip := range linode.IPv4 {
+		addr, err := netip.ParseAddr(ip)
+		if err != nil || !addr.Is4() || addr == shared {
+			continue
+		}
+
+		if addr.IsPrivate() {
+			return addr, true
+		}
+
+		if !public.IsValid() {
+			public = addr
+		}
+	}
+
+	return public, public.IsValid()
+}
+
+func sharesAddress(shared []Address, address string) bool {
+	for _, s := range shared {
+		if s.Address == address {
+			return true
+		}
+	}
+
+	return false
+}
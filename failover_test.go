@@ -0,0 +1,132 @@
+package lingo_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/eriktate/lingo"
+)
+
+// fakeSharing tracks which addresses are shared with which Linodes.
+type fakeSharing struct {
+	lingo.Networker
+	owners map[string]uint
+	shared map[uint][]string
+	calls  int
+}
+
+func (f *fakeSharing) ViewAddress(address string) (lingo.Address, error) {
+	return lingo.Address{Address: address, LinodeID: f.owners[address]}, nil
+}
+
+func (f *fakeSharing) ViewSharing(linodeID uint) ([]lingo.Address, error) {
+	var addrs []lingo.Address
+	for _, ip := range f.shared[linodeID] {
+		addrs = append(addrs, lingo.Address{Address: ip})
+	}
+
+	return addrs, nil
+}
+
+func (f *fakeSharing) ConfigureSharing(req lingo.SharingRequest) error {
+	f.calls++
+	f.shared[req.LinodeID] = req.IPs
+	return nil
+}
+
+func testFailoverLinodes() []lingo.Linode {
+	return []lingo.Linode{
+		{ID: 1, Label: "lb-1", Region: "us-east", IPv4: []string{"192.0.2.10", "192.168.130.10"}},
+		{ID: 2, Label: "lb-2", Region: "us-east", IPv4: []string{"192.0.2.20", "192.168.130.20"}},
+		{ID: 3, Label: "lb-3", Region: "us-east", IPv4: []string{"192.0.2.30"}},
+		{ID: 4, Label: "lb-4", Region: "eu-west", IPv4: []string{"192.0.2.40"}},
+	}
+}
+
+func Test_IPFailoverSetup(t *testing.T) {
+	network := &fakeSharing{
+		owners: map[string]uint{"192.0.2.10": 1},
+		shared: map[uint][]string{3: {"192.0.2.99"}},
+	}
+
+	f := lingo.NewIPFailover(fakeLinodes{linodes: testFailoverLinodes()}, network)
+	failover, err := f.Setup("192.0.2.10", []uint{2, 1, 3})
+	if err != nil {
+		t.Fatalf("Failed to set up failover: %s", err)
+	}
+
+	if len(network.shared[2]) != 1 || len(network.shared[3]) != 2 || network.shared[1] != nil {
+		t.Fatalf("Expected the address to be shared with lb-2 and lb-3 only, keeping lb-3's existing share, but got %v", network.shared)
+	}
+
+	if failover.DCID != 6 || !failover.Hosts[0].Primary || failover.Hosts[2].Priority != 130 {
+		t.Fatalf("Unexpected failover %+v", failover)
+	}
+
+	// Setting up the same failover again shouldn't reshare anything.
+	if _, err := f.Setup("192.0.2.10", []uint{2, 1, 3}); err != nil || network.calls != 2 {
+		t.Fatalf("Expected setup to be idempotent, but got %d sharing calls (%v)", network.calls, err)
+	}
+
+	keepalived, err := failover.Keepalived(2)
+	if err != nil {
+		t.Fatalf("Failed to render keepalived config: %s", err)
+	}
+
+	expected := `# lb-2 (Linode 2)
+vrrp_instance lingo_192_0_2_10 {
+	state MASTER
+	interface eth0
+	virtual_router_id 51
+	priority 150
+	advert_int 1
+	unicast_src_ip 192.168.130.20
+	unicast_peer {
+		192.168.130.10
+		192.0.2.30
+	}
+	virtual_ipaddress {
+		192.0.2.10/32
+	}
+}
+`
+	if keepalived != expected {
+		t.Fatalf("Expected keepalived config:\n%s\nbut got:\n%s", expected, keepalived)
+	}
+
+	backup, _ := failover.Keepalived(1)
+	if !strings.Contains(backup, "state BACKUP\n") || !strings.Contains(backup, "priority 140\n") {
+		t.Fatalf("Expected lb-1 to be a priority 140 backup, but got:\n%s", backup)
+	}
+
+	primary, _ := failover.Lelastic(2)
+	secondary, _ := failover.Lelastic(3)
+	if !strings.Contains(primary, "lelastic -dcid 6 -primary\n") || !strings.Contains(secondary, "lelastic -dcid 6 -secondary\n") {
+		t.Fatalf("Expected primary and secondary lelastic units, but got:\n%s\n%s", primary, secondary)
+	}
+}
+
+func Test_IPFailoverErrors(t *testing.T) {
+	network := &fakeSharing{owners: map[string]uint{"192.0.2.10": 1}, shared: map[uint][]string{}}
+	f := lingo.NewIPFailover(fakeLinodes{linodes: testFailoverLinodes()}, network)
+
+	cases := map[string][]uint{
+		"192.0.2.10":  {1, 4},
+		"192.0.2.11":  {1, 2},
+		"2001:db8::1": {1, 2},
+	}
+
+	for address, ids := range cases {
+		if _, err := f.Setup(address, ids); err == nil {
+			t.Fatalf("Expected failover of %s between %v to fail", address, ids)
+		}
+	}
+
+	if _, err := f.Setup("192.0.2.10", []uint{1}); err == nil {
+		t.Fatal("Expected failover with a single Linode to fail")
+	}
+
+	if network.calls != 0 {
+		t.Fatalf("Expected failed setups not to share anything, but got %d calls", network.calls)
+	}
+}
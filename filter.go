@@ -0,0 +1,10 @@
+package lingo
+
+// A Filter narrows down the results of a List call. It's marshalled to JSON and sent to the
+// Linode API in the X-Filter header, so any filter the API understands can be expressed with it.
+type Filter map[string]interface{}
+
+// TagFilter returns a Filter that only matches resources carrying the given tag.
+func TagFilter(tag string) Filter {
+	return Filter{"tags": tag}
+}
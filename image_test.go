@@ -36,11 +36,11 @@ func Test_Image(t *testing.T) {
 	}
 
 	createLinode := lingo.CreateLinodeRequest{
-		Region:   "us-east-1a",
-		Type:     "g5-nanode-1",
-		Image:    "linode/debian9",
-		RootPass: "test123",
-		Booted:   true,
+		Region:           "us-east-1a",
+		Type:             "g5-nanode-1",
+		Image:            "linode/debian9",
+		GenerateRootPass: true,
+		Booted:           true,
 	}
 
 	linode, err := linodeClient.CreateLinode(createLinode)
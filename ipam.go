@@ -0,0 +1,169 @@
+package lingo
+
+import (
+	"fmt"
+	"net/netip"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// An IPAssignment is an address handed out to a named service.
+type IPAssignment struct {
+	Service string     `json:"service"`
+	Address netip.Addr `json:"address"`
+}
+
+// An IPAM hands out addresses from a single prefix, usually an IPv6 range routed to a Linode, to
+// named services on that Linode. Free addresses are always picked lowest first, so replaying the
+// same allocations gives the same addresses. The network address, and for IPv4 the broadcast
+// address, are never handed out. An IPAM isn't safe for concurrent use.
+type IPAM struct {
+	prefix   netip.Prefix
+	services map[string]netip.Addr
+	used     map[netip.Addr]string
+}
+
+// NewIPAM returns a new IPAM handing out addresses from the prefix.
+func NewIPAM(prefix netip.Prefix) (*IPAM, error) {
+	if !prefix.IsValid() {
+		return nil, errors.New("an IPAM needs a valid prefix")
+	}
+
+	return &IPAM{
+		prefix:   prefix.Masked(),
+		services: make(map[string]netip.Addr),
+		used:     make(map[netip.Addr]string),
+	}, nil
+}
+
+// NewIPAMForRange returns a new IPAM handing out addresses from an IPv6 range.
+func NewIPAMForRange(r IPv6Range) (*IPAM, error) {
+	prefix, err := r.CIDR()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewIPAM(prefix)
+}
+
+// Prefix returns the prefix addresses are handed out from.
+func (m *IPAM) Prefix() netip.Prefix {
+	return m.prefix
+}
+
+// Allocate hands out the lowest free address to a service. A service that already has an address
+// gets the same one back.
+func (m *IPAM) Allocate(service string) (netip.Addr, error) {
+	if addr, ok := m.services[service]; ok {
+		return addr, nil
+	}
+
+	for addr := m.prefix.Addr().Next(); m.usable(addr); addr = addr.Next() {
+		if _, taken := m.used[addr]; !taken {
+			m.assign(service, addr)
+			return addr, nil
+		}
+	}
+
+	return netip.Addr{}, errors.Errorf("no free addresses left in %s for %s", m.prefix, service)
+}
+
+// Assign records that a service holds a specific address, such as one handed out by an earlier run.
+func (m *IPAM) Assign(service string, addr netip.Addr) error {
+	if !m.usable(addr) || addr == m.prefix.Addr() {
+		return errors.Errorf("%s can't be assigned from %s", addr, m.prefix)
+	}
+
+	if owner, taken := m.used[addr]; taken && owner != service {
+		return errors.Errorf("%s is already assigned to %s", addr, owner)
+	}
+
+	if current, ok := m.services[service]; ok && current != addr {
+		return errors.Errorf("%s is already assigned %s", service, current)
+	}
+
+	m.assign(service, addr)
+	return nil
+}
+
+// Release frees a service's address so it can be handed out again.
+func (m *IPAM) Release(service string) {
+	if addr, ok := m.services[service]; ok {
+		delete(m.used, addr)
+		delete(m.services, service)
+	}
+}
+
+// Lookup returns the address assigned to a service, if it has one.
+func (m *IPAM) Lookup(service string) (netip.Addr, bool) {
+	addr, ok := m.services[service]
+	return addr, ok
+}
+
+// Assignments returns every assignment ordered by address.
+func (m *IPAM) Assignments() []IPAssignment {
+	assignments := make([]IPAssignment, 0, len(m.services))
+	for service, addr := range m.services {
+		assignments = append(assignments, IPAssignment{Service: service, Address: addr})
+	}
+
+	sort.Slice(assignments, func(i, j int) bool {
+		return assignments[i].Address.Less(assignments[j].Address)
+	})
+
+	return assignments
+}
+
+// Netplan renders a netplan snippet configuring every assigned address on the interface. Netplan
+// merges files in /etc/netplan, so the snippet can be dropped in alongside the existing config,
+// for example as /etc/netplan/60-lingo.yaml.
+func (m *IPAM) Netplan(iface string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "network:\n  version: 2\n  ethernets:\n    %s:\n      addresses:\n", iface)
+	for _, a := range m.Assignments() {
+		fmt.Fprintf(&b, "        - %q # %s\n", netip.PrefixFrom(a.Address, m.prefix.Bits()), a.Service)
+	}
+
+	return b.String()
+}
+
+// Ifupdown renders /etc/network/interfaces stanzas configuring every assigned address on the
+// interface, one stanza per address.
+func (m *IPAM) Ifupdown(iface string) string {
+	family := "inet"
+	if m.prefix.Addr().Is6() {
+		family = "inet6"
+	}
+
+	var b strings.Builder
+	for i, a := range m.Assignments() {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+
+		fmt.Fprintf(&b, "# %s\niface %s %s static\n\taddress %s\n", a.Service, iface, family, netip.PrefixFrom(a.Address, m.prefix.Bits()))
+	}
+
+	return b.String()
+}
+
+// usable reports whether an address can be handed out from the prefix.
+func (m *IPAM) usable(addr netip.Addr) bool {
+	if !addr.IsValid() || !m.prefix.Contains(addr) {
+		return false
+	}
+
+	// The last address of an IPv4 network is its broadcast address.
+	if addr.Is4() && m.prefix.Bits() < 31 && !m.prefix.Contains(addr.Next()) {
+		return false
+	}
+
+	return true
+}
+
+func (m *IPAM) assign(service string, addr netip.Addr) {
+	m.services[service] = addr
+	m.used[addr] = service
+}
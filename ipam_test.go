@@ -0,0 +1,105 @@
+package lingo_test
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/eriktate/lingo"
+)
+
+func Test_IPAMAllocate(t *testing.T) {
+	ipam, err := lingo.NewIPAMForRange(lingo.IPv6Range{Range: "2001:db8:0:1::", Prefix: 64})
+	if err != nil {
+		t.Fatalf("Failed to create IPAM: %s", err)
+	}
+
+	if err := ipam.Assign("web", netip.MustParseAddr("2001:db8:0:1::2")); err != nil {
+		t.Fatalf("Failed to assign address: %s", err)
+	}
+
+	api, err := ipam.Allocate("api")
+	if err != nil {
+		t.Fatalf("Failed to allocate address: %s", err)
+	}
+
+	db, _ := ipam.Allocate("db")
+	if api.String() != "2001:db8:0:1::1" || db.String() != "2001:db8:0:1::3" {
+		t.Fatalf("Expected the lowest free addresses, but got %s and %s", api, db)
+	}
+
+	if again, _ := ipam.Allocate("api"); again != api {
+		t.Fatalf("Expected api to keep %s, but got %s", api, again)
+	}
+
+	if err := ipam.Assign("cache", netip.MustParseAddr("2001:db8:0:1::2")); err == nil {
+		t.Fatal("Expected assigning a taken address to fail")
+	}
+
+	if err := ipam.Assign("cache", netip.MustParseAddr("2001:db8:0:2::1")); err == nil {
+		t.Fatal("Expected assigning an address outside the range to fail")
+	}
+
+	ipam.Release("api")
+	if cache, _ := ipam.Allocate("cache"); cache != api {
+		t.Fatalf("Expected a released address to be reused, but got %s", cache)
+	}
+
+	expectedNetplan := `network:
+  version: 2
+  ethernets:
+    eth0:
+      addresses:
+        - "2001:db8:0:1::1/64" # cache
+        - "2001:db8:0:1::2/64" # web
+        - "2001:db8:0:1::3/64" # db
+`
+	if netplan := ipam.Netplan("eth0"); netplan != expectedNetplan {
+		t.Fatalf("Expected netplan:\n%s\nbut got:\n%s", expectedNetplan, netplan)
+	}
+
+	expectedIfupdown := "# cache\niface eth0 inet6 static\n\taddress 2001:db8:0:1::1/64\n\n" +
+		"# web\niface eth0 inet6 static\n\taddress 2001:db8:0:1::2/64\n\n" +
+		"# db\niface eth0 inet6 static\n\taddress 2001:db8:0:1::3/64\n"
+	if ifupdown := ipam.Ifupdown("eth0"); ifupdown != expectedIfupdown {
+		t.Fatalf("Expected ifupdown:\n%s\nbut got:\n%s", expectedIfupdown, ifupdown)
+	}
+}
+
+func Test_IPAMExhausted(t *testing.T) {
+	ipam, _ := lingo.NewIPAM(netip.MustParsePrefix("192.168.10.0/30"))
+
+	for _, service := range []string{"a", "b"} {
+		if _, err := ipam.Allocate(service); err != nil {
+			t.Fatalf("Failed to allocate address for %s: %s", service, err)
+		}
+	}
+
+	if addr, err := ipam.Allocate("c"); err == nil {
+		t.Fatalf("Expected the broadcast address to be held back, but got %s", addr)
+	}
+}
+
+func Test_NetworkCIDR(t *testing.T) {
+	addr := lingo.Address{Address: "192.0.2.5", SubnetMask: "255.255.255.0", Gateway: "192.0.2.1"}
+	cidr, err := addr.CIDR()
+	if err != nil || cidr.String() != "192.0.2.5/24" {
+		t.Fatalf("Expected 192.0.2.5/24, but got %s (%v)", cidr, err)
+	}
+
+	if gw, err := addr.GatewayAddr(); err != nil || gw.String() != "192.0.2.1" {
+		t.Fatalf("Expected gateway 192.0.2.1, but got %s (%v)", gw, err)
+	}
+
+	if _, err := (lingo.Address{Address: "192.0.2.5", SubnetMask: "255.0.255.0"}).CIDR(); err == nil {
+		t.Fatal("Expected a non-contiguous subnet mask to be rejected")
+	}
+
+	pool, err := lingo.IPv6Pool{Range: "2001:db8:1::/56"}.CIDR()
+	if err != nil || pool.String() != "2001:db8:1::/56" {
+		t.Fatalf("Expected 2001:db8:1::/56, but got %s (%v)", pool, err)
+	}
+
+	if _, err := (lingo.IPv6Range{Range: "2001:db8::"}).CIDR(); err == nil {
+		t.Fatal("Expected a range without a prefix length to be rejected")
+	}
+}
@@ -0,0 +1,204 @@
+package lingo
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// An Architecture is an enumeration of possible kernel architectures.
+type Architecture string
+
+// Enum values for Architecture.
+const (
+	ArchitectureX86_64 = Architecture("x86_64")
+	ArchitectureI386   = Architecture("i386")
+)
+
+// Well known kernel IDs. The latest kernels are aliases that follow Linode's newest kernel, while
+// the bootloaders boot whatever kernel the distribution on the disk has installed.
+const (
+	KernelLatest64   = "linode/latest-64bit"
+	KernelLatest32   = "linode/latest-32bit"
+	KernelGRUB2      = "linode/grub2"
+	KernelGRUBLegacy = "linode/grub-legacy"
+	KernelDirectDisk = "linode/direct-disk"
+)
+
+// A Kernel represents a kernel a Linode config can boot.
+type Kernel struct {
+	ID           string       `json:"id"`
+	Label        string       `json:"label"`
+	Version      string       `json:"version"`
+	Architecture Architecture `json:"architecture"`
+	KVM          bool         `json:"kvm"`
+	Xen          bool         `json:"xen"`
+	PVOPS        bool         `json:"pvops"`
+	Deprecated   bool         `json:"deprecated"`
+	Built        *Time        `json:"built"`
+}
+
+// IsAlias reports whether the Kernel is one of the latest aliases rather than a concrete kernel.
+func (k Kernel) IsAlias() bool {
+	return strings.HasPrefix(k.ID, "linode/latest")
+}
+
+// IsBootloader reports whether the Kernel boots the distribution's own kernel, such as GRUB 2.
+func (k Kernel) IsBootloader() bool {
+	id := strings.TrimPrefix(k.ID, "linode/")
+	return strings.HasPrefix(id, "grub") || strings.HasPrefix(id, "pv-grub") || id == "direct-disk"
+}
+
+// A KernelFilter narrows down which kernels are listed. Nil fields match any kernel. BuiltAfter
+// isn't understood by the API, so it's applied once the kernels have been fetched.
+type KernelFilter struct {
+	Architecture Architecture
+	KVM          *bool
+	Xen          *bool
+	PVOPS        *bool
+	Deprecated   *bool
+	BuiltAfter   time.Time
+}
+
+// Filter returns the API Filter for the KernelFilter.
+func (f KernelFilter) Filter() Filter {
+	filter := Filter{}
+	if f.Architecture != "" {
+		filter["architecture"] = f.Architecture
+	}
+
+	if f.KVM != nil {
+		filter["kvm"] = *f.KVM
+	}
+
+	if f.Xen != nil {
+		filter["xen"] = *f.Xen
+	}
+
+	if f.PVOPS != nil {
+		filter["pvops"] = *f.PVOPS
+	}
+
+	if f.Deprecated != nil {
+		filter["deprecated"] = *f.Deprecated
+	}
+
+	return filter
+}
+
+// Matches reports whether a Kernel passes the filter.
+func (f KernelFilter) Matches(k Kernel) bool {
+	switch {
+	case f.Architecture != "" && k.Architecture != f.Architecture:
+		return false
+	case f.KVM != nil && k.KVM != *f.KVM:
+		return false
+	case f.Xen != nil && k.Xen != *f.Xen:
+		return false
+	case f.PVOPS != nil && k.PVOPS != *f.PVOPS:
+		return false
+	case f.Deprecated != nil && k.Deprecated != *f.Deprecated:
+		return false
+	case !f.BuiltAfter.IsZero() && (k.Built == nil || !k.Built.After(f.BuiltAfter)):
+		return false
+	default:
+		return true
+	}
+}
+
+// A Kerneler works with Linode kernels.
+type Kerneler interface {
+	ListKernels() ([]Kernel, error)
+	ListKernelsFiltered(filter KernelFilter) ([]Kernel, error)
+	ViewKernel(id string) (Kernel, error)
+}
+
+// ResolveKernel turns a kernel ID into the concrete kernel it boots, for pinning configs to a
+// known kernel. A latest alias resolves to the newest KVM kernel of its version and architecture
+// that isn't deprecated. Any other kernel, including the bootloaders, resolves to itself as long
+// as it isn't deprecated.
+func ResolveKernel(kernels Kerneler, id string) (Kernel, error) {
+	kernel, err := kernels.ViewKernel(id)
+	if err != nil {
+		return kernel, err
+	}
+
+	if !kernel.IsAlias() {
+		if kernel.Deprecated {
+			return kernel, errors.Errorf("kernel %s is deprecated", id)
+		}
+
+		return kernel, nil
+	}
+
+	kvm, deprecated := true, false
+	candidates, err := kernels.ListKernelsFiltered(KernelFilter{
+		Architecture: kernel.Architecture,
+		KVM:          &kvm,
+		Deprecated:   &deprecated,
+	})
+	if err != nil {
+		return kernel, err
+	}
+
+	var concrete []Kernel
+	for _, candidate := range candidates {
+		if !candidate.IsAlias() && !candidate.IsBootloader() {
+			concrete = append(concrete, candidate)
+		}
+	}
+
+	if len(concrete) == 0 {
+		return kernel, errors.Errorf("no concrete kernel found for %s", id)
+	}
+
+	// Prefer the alias's own version, falling back on the newest version there is.
+	sort.SliceStable(concrete, func(i, j int) bool {
+		if c := compareKernelVersions(concrete[i].Version, concrete[j].Version); c != 0 {
+			return c > 0
+		}
+
+		return builtTime(concrete[i]).After(builtTime(concrete[j]))
+	})
+
+	for _, candidate := range concrete {
+		if candidate.Version == kernel.Version {
+			return candidate, nil
+		}
+	}
+
+	return concrete[0], nil
+}
+
+// compareKernelVersions compares dotted version numbers such as 6.2.9, returning a positive number
+// when a is newer.
+func compareKernelVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+
+		if x != y {
+			return x - y
+		}
+	}
+
+	return 0
+}
+
+func builtTime(k Kernel) time.Time {
+	if k.Built == nil {
+		return time.Time{}
+	}
+
+	return k.Built.Time
+}
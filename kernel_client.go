@@ -0,0 +1,99 @@
+package lingo
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// kernelPageSize is the largest page the API serves. There are hundreds of kernels, so they're
+// fetched a page at a time.
+const kernelPageSize = 500
+
+// A KernelClient implements the Kerneler interface and provides access to Linode kernels.
+type KernelClient struct {
+	api APIClient
+}
+
+// NewKernelClient returns a new KernelClient given a valid APIClient.
+func NewKernelClient(api APIClient) KernelClient {
+	return KernelClient{api: api}
+}
+
+// ListKernels retrieves every kernel.
+func (c KernelClient) ListKernels() ([]Kernel, error) {
+	kernels, err := c.listKernels(nil)
+	return kernels, errors.Wrap(err, "failed to list kernels for ListKernels")
+}
+
+// ListKernelsFiltered retrieves every kernel matching the filter.
+func (c KernelClient) ListKernelsFiltered(filter KernelFilter) ([]Kernel, error) {
+	kernels, err := c.listKernels(filter.Filter())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list kernels for ListKernelsFiltered")
+	}
+
+	var matched []Kernel
+	for _, kernel := range kernels {
+		if filter.Matches(kernel) {
+			matched = append(matched, kernel)
+		}
+	}
+
+	return matched, nil
+}
+
+// ViewKernel retrieves a single kernel. Kernel IDs contain a slash, which the API expects to be left
+// as it is in the path.
+func (c KernelClient) ViewKernel(id string) (Kernel, error) {
+	var kernel Kernel
+	data, err := c.api.Get(fmt.Sprintf("linode/kernels/%s", id))
+	if err != nil {
+		return kernel, errors.Wrap(err, "failed to make request for ViewKernel")
+	}
+
+	if err := json.Unmarshal(data, &kernel); err != nil {
+		return kernel, errors.Wrap(err, "failed to decode ViewKernel response")
+	}
+
+	return kernel, nil
+}
+
+// listKernels fetches every page of kernels, applying the filter if there is one.
+func (c KernelClient) listKernels(filter Filter) ([]Kernel, error) {
+	var kernels []Kernel
+	for page := uint(1); ; page++ {
+		path := fmt.Sprintf("linode/kernels?page=%d&page_size=%d", page, kernelPageSize)
+
+		var (
+			data []byte
+			err  error
+		)
+
+		if filter != nil {
+			data, err = c.api.GetFiltered(path, filter)
+		} else {
+			data, err = c.api.Get(path)
+		}
+
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to make request")
+		}
+
+		var results Results
+		if err := json.Unmarshal(data, &results); err != nil {
+			return nil, errors.Wrap(err, "failed to decode response")
+		}
+
+		var pageKernels []Kernel
+		if err := json.Unmarshal(results.Data, &pageKernels); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal data")
+		}
+
+		kernels = append(kernels, pageKernels...)
+		if page >= results.Pages {
+			return kernels, nil
+		}
+	}
+}
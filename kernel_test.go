@@ -0,0 +1,129 @@
+package lingo_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/eriktate/lingo"
+	"github.com/pkg/errors"
+)
+
+// fakeKernels serves a fixed set of kernels, applying filters the same way the client does.
+type fakeKernels struct {
+	kernels []lingo.Kernel
+}
+
+func (f fakeKernels) ListKernels() ([]lingo.Kernel, error) {
+	return f.kernels, nil
+}
+
+func (f fakeKernels) ListKernelsFiltered(filter lingo.KernelFilter) ([]lingo.Kernel, error) {
+	var matched []lingo.Kernel
+	for _, kernel := range f.kernels {
+		if filter.Matches(kernel) {
+			matched = append(matched, kernel)
+		}
+	}
+
+	return matched, nil
+}
+
+func (f fakeKernels) ViewKernel(id string) (lingo.Kernel, error) {
+	for _, kernel := range f.kernels {
+		if kernel.ID == id {
+			return kernel, nil
+		}
+	}
+
+	return lingo.Kernel{}, errors.Errorf("no kernel %s", id)
+}
+
+func built(year int) *lingo.Time {
+	return &lingo.Time{Time: time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func testKernels() []lingo.Kernel {
+	return []lingo.Kernel{
+		{ID: lingo.KernelLatest64, Version: "6.2.9", Architecture: lingo.ArchitectureX86_64, KVM: true},
+		{ID: lingo.KernelLatest32, Version: "4.9.0", Architecture: lingo.ArchitectureI386, KVM: true},
+		{ID: lingo.KernelGRUB2, Version: "2.06", Architecture: lingo.ArchitectureX86_64, KVM: true},
+		{ID: "linode/grub-legacy", Architecture: lingo.ArchitectureX86_64, KVM: true, Deprecated: true},
+		{ID: "linode/6.2.9-x86_64-linode160", Version: "6.2.9", Architecture: lingo.ArchitectureX86_64, KVM: true, Built: built(2023)},
+		{ID: "linode/6.10.1-x86_64-linode170", Version: "6.10.1", Architecture: lingo.ArchitectureX86_64, KVM: true, Built: built(2024)},
+		{ID: "linode/6.1.0-x86_64-linode150", Version: "6.1.0", Architecture: lingo.ArchitectureX86_64, KVM: true, Built: built(2022)},
+		{ID: "linode/4.9.0-x86-linode80", Version: "4.9.0", Architecture: lingo.ArchitectureI386, KVM: true, Deprecated: true, Built: built(2017)},
+		{ID: "linode/4.8.0-x86-linode79", Version: "4.8.0", Architecture: lingo.ArchitectureI386, KVM: true, Built: built(2016)},
+	}
+}
+
+func Test_ResolveKernel(t *testing.T) {
+	kernels := fakeKernels{kernels: testKernels()}
+
+	cases := map[string]string{
+		lingo.KernelLatest64:            "linode/6.2.9-x86_64-linode160",
+		lingo.KernelLatest32:            "linode/4.8.0-x86-linode79",
+		lingo.KernelGRUB2:               lingo.KernelGRUB2,
+		"linode/6.1.0-x86_64-linode150": "linode/6.1.0-x86_64-linode150",
+	}
+
+	for id, expected := range cases {
+		kernel, err := lingo.ResolveKernel(kernels, id)
+		if err != nil {
+			t.Fatalf("Failed to resolve %s: %s", id, err)
+		}
+
+		if kernel.ID != expected {
+			t.Fatalf("Expected %s to resolve to %s, but got %s", id, expected, kernel.ID)
+		}
+	}
+
+	if _, err := lingo.ResolveKernel(kernels, "linode/grub-legacy"); err == nil {
+		t.Fatal("Expected a deprecated kernel to be rejected")
+	}
+
+	// Without a concrete kernel of its own version, an alias falls back on the newest one.
+	kernels.kernels[0].Version = "6.11.0"
+	if kernel, _ := lingo.ResolveKernel(kernels, lingo.KernelLatest64); kernel.ID != "linode/6.10.1-x86_64-linode170" {
+		t.Fatalf("Expected the newest kernel, but got %s", kernel.ID)
+	}
+}
+
+func Test_KernelFilter(t *testing.T) {
+	kvm := true
+	filter := lingo.KernelFilter{Architecture: lingo.ArchitectureX86_64, KVM: &kvm, BuiltAfter: time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)}
+
+	f := filter.Filter()
+	if len(f) != 2 || f["architecture"] != lingo.ArchitectureX86_64 || f["kvm"] != true {
+		t.Fatalf("Expected only architecture and kvm to be sent to the API, but got %v", f)
+	}
+
+	matched, _ := fakeKernels{kernels: testKernels()}.ListKernelsFiltered(filter)
+	if len(matched) != 2 {
+		t.Fatalf("Expected the two x86_64 kernels built after mid 2022, but got %+v", matched)
+	}
+}
+
+func Test_Kernels(t *testing.T) {
+	apiKey := os.Getenv("LINODE_API_KEY")
+	api := lingo.NewAPIClient(apiKey, nil)
+	client := lingo.NewKernelClient(api)
+
+	kernels, err := client.ListKernels()
+	if err != nil {
+		t.Fatalf("Failed to list kernels: %s", err)
+	}
+
+	if len(kernels) == 0 {
+		t.Fatal("Expected to list some kernels")
+	}
+
+	kernel, err := lingo.ResolveKernel(client, lingo.KernelLatest64)
+	if err != nil {
+		t.Fatalf("Failed to resolve the latest kernel: %s", err)
+	}
+
+	if kernel.IsAlias() || kernel.Architecture != lingo.ArchitectureX86_64 {
+		t.Fatalf("Expected a concrete x86_64 kernel, but got %+v", kernel)
+	}
+}
@@ -57,8 +57,13 @@ type Linode struct {
 	Status     Status     `json:"status"`
 	Hypervisor Hypervisor `json:"hypervisor"`
 	Specs      Specs      `json:"specs"`
+	Tags       []string   `json:"tags"`
 	Created    Time       `json:"created"`
 	Updatd     Time       `json:"updated"`
+
+	// RootPass is only set on the Linode returned by CreateLinode or RebuildLinode when they
+	// generated its root password. It isn't stored anywhere else, so keep it if you need it.
+	RootPass Secret `json:"-"`
 }
 
 // CreateLinodeRequest is a paremeter struct h
@@ -66,7 +71,7 @@ type CreateLinodeRequest struct {
 	Region          string          `json:"region"`
 	Type            string          `json:"type"`
 	Label           string          `json:"label,omitempty"`
-	RootPass        string          `json:"root_pass,omitempty"`
+	RootPass        Secret          `json:"root_pass,omitempty"`
 	AuthorizedKeys  []string        `json:"authorized_keys,omitempty"`
 	StackScriptID   uint            `json:"stackscript_id,omitempty"`
 	StackscriptData json.RawMessage `json:"stackscript_data,omitempty"`
@@ -75,13 +80,20 @@ type CreateLinodeRequest struct {
 	BackupsEnabled  bool            `json:"backups_enabled"`
 	Booted          bool            `json:"booted"`
 	SwapSize        uint            `json:"swap_size,omitempty"`
+	Tags            []string        `json:"tags,omitempty"`
+	Metadata        *Metadata       `json:"metadata,omitempty"`
+
+	// GenerateRootPass generates a root password when RootPass is empty.
+	GenerateRootPass bool `json:"-"`
 }
 
 // UpdateLinodeRequest is a parameter struct for specifying how to update an existing instance.
+// Tags are left alone unless set, and TagList() with no tags clears them.
 type UpdateLinodeRequest struct {
-	ID     uint   `json:"-"`
-	Label  string `json:"label,omitempty"`
-	Alerts Alerts `json:"alerts,omitempty"`
+	ID     uint      `json:"-"`
+	Label  string    `json:"label,omitempty"`
+	Alerts Alerts    `json:"alerts,omitempty"`
+	Tags   *[]string `json:"tags,omitempty"`
 }
 
 // CloneLinodeRequest is a parameter struct for specifying a clone to be created.
@@ -100,11 +112,53 @@ type CloneLinodeRequest struct {
 type RebuildLinodeRequest struct {
 	ID              uint            `json:"-"`
 	Image           string          `json:"image"`
-	RootPass        string          `json:"root_pass"`
+	RootPass        Secret          `json:"root_pass"`
 	AuthorizedKeys  []string        `json:"authorized_keys,omitempty"`
 	StackScriptID   uint            `json:"stackscript_id,omitempty"`
 	StackscriptData json.RawMessage `json:"stackscript_data,omitempty"`
 	Booted          bool            `json:"booted"`
+	Metadata        *Metadata       `json:"metadata,omitempty"`
+
+	// GenerateRootPass generates a root password when RootPass is empty.
+	GenerateRootPass bool `json:"-"`
+}
+
+// A MigrationType is an enumeration of the ways a Linode can be moved to a new host. Warm
+// migrations keep the Linode running for most of the move, while cold migrations shut it down
+// first.
+type MigrationType string
+
+// Enum values for MigrationType.
+const (
+	MigrationTypeWarm = MigrationType("warm")
+	MigrationTypeCold = MigrationType("cold")
+)
+
+// MigrateLinodeRequest is a parameter struct for migrating an instance. An empty Region migrates
+// the instance within its datacenter, which is how pending maintenance migrations are started, and
+// Upgrade moves it to newer hardware if any is waiting for it.
+type MigrateLinodeRequest struct {
+	ID      uint          `json:"-"`
+	Region  string        `json:"region,omitempty"`
+	Upgrade bool          `json:"upgrade,omitempty"`
+	Type    MigrationType `json:"type,omitempty"`
+}
+
+// ResizeLinodeRequest is a parameter struct for resizing an instance to a new type. With
+// AllowAutoDiskResize set, a Linode with a single ext disk, or a single ext disk and a swap disk,
+// has its ext disk grown to fill the new type.
+type ResizeLinodeRequest struct {
+	ID                  uint          `json:"-"`
+	Type                string        `json:"type"`
+	AllowAutoDiskResize bool          `json:"allow_auto_disk_resize"`
+	MigrationType       MigrationType `json:"migration_type,omitempty"`
+}
+
+// RescueLinodeRequest is a parameter struct for booting an instance into rescue mode with the given
+// disks and volumes attached. Rescue mode boots from sdh, so it can't be mapped.
+type RescueLinodeRequest struct {
+	ID      uint          `json:"-"`
+	Devices ConfigDevices `json:"devices"`
 }
 
 // A Class is an enum of possible instance classes.
@@ -147,20 +201,23 @@ type LinodeType struct {
 // A Linoder works with Linode instances.
 type Linoder interface {
 	ListLinodes() ([]Linode, error)
+	ListLinodesByTag(tag string) ([]Linode, error)
 	ViewLinode(id uint) (Linode, error)
 	CreateLinode(req CreateLinodeRequest) (Linode, error)
-	UpdateLinode(req UpdateLinodeRequest)
+	UpdateLinode(req UpdateLinodeRequest) (Linode, error)
 	DeleteLinode(id uint) error
 	BootLinode(id uint) error
 	BootLinodeWithConfig(id, configID uint) error
 	RebootLinode(id uint) error
 	RebootLinodeWithConfig(id, configID uint) error
 	ShutdownLinode(id uint) error
-	ResizeLinode(id uint, typeID string) error
-	Upgrade(id uint, typeID string) error
+	ResizeLinode(req ResizeLinodeRequest) (Operation, error)
+	Upgrade(id uint, allowAutoDiskResize bool) (Operation, error)
 	CloneLinode(req CloneLinodeRequest) (Linode, error)
-	RebuildLinode(req RebuildLinodeRequest) error
+	RebuildLinode(req RebuildLinodeRequest) (Linode, error)
 	ListLinodeVolumes(id uint) ([]Volume, error)
+	MigrateLinode(req MigrateLinodeRequest) (Operation, error)
+	RescueLinode(req RescueLinodeRequest) (Operation, error)
 	ListTypes() ([]LinodeType, error)
 	ViewType(id string) (LinodeType, error)
 }
@@ -18,6 +18,11 @@ func NewLinodeClient(api APIClient) LinodeClient {
 func (c LinodeClient) CreateLinode(linode CreateLinodeRequest) (Linode, error) {
 	var created Linode
 
+	generated, err := generateRootPass(&linode.RootPass, linode.GenerateRootPass)
+	if err != nil {
+		return created, errors.Wrap(err, "failed to generate root password for CreateLinode")
+	}
+
 	payload, err := json.Marshal(&linode)
 	if err != nil {
 		return created, errors.Wrap(err, "failed to marshal request for CreateLinode")
@@ -31,6 +36,7 @@ func (c LinodeClient) CreateLinode(linode CreateLinodeRequest) (Linode, error) {
 	if err := json.Unmarshal(data, &created); err != nil {
 		return created, errors.Wrap(err, "failed to decode CreateLinode response")
 	}
+	created.RootPass = generated
 
 	return created, nil
 }
@@ -74,6 +80,26 @@ func (c LinodeClient) ListLinodes() ([]Linode, error) {
 	return linodes, nil
 }
 
+// ListLinodesByTag retrieves a slice of the Linode instances carrying the given tag.
+func (c LinodeClient) ListLinodesByTag(tag string) ([]Linode, error) {
+	data, err := c.api.GetFiltered("linode/instances", TagFilter(tag))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make request for ListLinodesByTag")
+	}
+
+	var results Results
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal ListLinodesByTag response")
+	}
+
+	var linodes []Linode
+	if err := json.Unmarshal(results.Data, &linodes); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal ListLinodesByTag data")
+	}
+
+	return linodes, nil
+}
+
 func (c LinodeClient) ViewLinode(id uint) (Linode, error) {
 	var linode Linode
 
@@ -190,29 +216,48 @@ func (c LinodeClient) ViewType(id string) (LinodeType, error) {
 	return linodeType, nil
 }
 
-func (c LinodeClient) ResizeLinode(id uint, typeID string) error {
-	typePayload := struct {
-		Type string `json:"type"`
-	}{typeID}
+// ResizeLinode starts resizing an instance to a new type. The returned Operation can be used to
+// wait on the resize, which includes migrating the instance to a new host.
+func (c LinodeClient) ResizeLinode(req ResizeLinodeRequest) (Operation, error) {
+	op, err := NewOperation(NewEventClient(c.api), req.ID, EventActionLinodeResize)
+	if err != nil {
+		return op, err
+	}
 
-	payload, err := json.Marshal(&typePayload)
+	payload, err := json.Marshal(req)
 	if err != nil {
-		return errors.Wrap(err, "failed to marshal request for ResizeLinode")
+		return op, errors.Wrap(err, "failed to marshal request for ResizeLinode")
 	}
 
-	if _, err := c.api.Post(fmt.Sprintf("linode/instances/%d/resize", id), payload); err != nil {
-		return errors.Wrap(err, "failed to make request for ResizeLinode")
+	if _, err := c.api.Post(fmt.Sprintf("linode/instances/%d/resize", req.ID), payload); err != nil {
+		return op, errors.Wrap(err, "failed to make request for ResizeLinode")
 	}
 
-	return nil
+	return op, nil
 }
 
-func (c LinodeClient) Upgrade(id uint, typeID string) error {
-	if _, err := c.api.Post(fmt.Sprintf("linode/instances/%d/mutate", id), nil); err != nil {
-		return errors.Wrap(err, "failed to create request for Mutate")
+// Upgrade moves an instance to the newest generation of its type, when one is available. The
+// returned Operation can be used to wait on the upgrade.
+func (c LinodeClient) Upgrade(id uint, allowAutoDiskResize bool) (Operation, error) {
+	op, err := NewOperation(NewEventClient(c.api), id, EventActionLinodeMutate)
+	if err != nil {
+		return op, err
+	}
+
+	mutate := struct {
+		AllowAutoDiskResize bool `json:"allow_auto_disk_resize"`
+	}{allowAutoDiskResize}
+
+	payload, err := json.Marshal(mutate)
+	if err != nil {
+		return op, errors.Wrap(err, "failed to marshal request for Upgrade")
 	}
 
-	return nil
+	if _, err := c.api.Post(fmt.Sprintf("linode/instances/%d/mutate", id), payload); err != nil {
+		return op, errors.Wrap(err, "failed to make request for Upgrade")
+	}
+
+	return op, nil
 }
 
 func (c LinodeClient) CloneLinode(req CloneLinodeRequest) (Linode, error) {
@@ -238,6 +283,11 @@ func (c LinodeClient) CloneLinode(req CloneLinodeRequest) (Linode, error) {
 func (c LinodeClient) RebuildLinode(req RebuildLinodeRequest) (Linode, error) {
 	var linode Linode
 
+	generated, err := generateRootPass(&req.RootPass, req.GenerateRootPass)
+	if err != nil {
+		return linode, errors.Wrap(err, "failed to generate root password for RebuildLinode")
+	}
+
 	payload, err := json.Marshal(req)
 	if err != nil {
 		return linode, errors.Wrap(err, "failed to marshal request for RebuildLinode")
@@ -251,6 +301,7 @@ func (c LinodeClient) RebuildLinode(req RebuildLinodeRequest) (Linode, error) {
 	if err := json.Unmarshal(data, &linode); err != nil {
 		return linode, errors.Wrap(err, "failed to unmarshal RebuildLinode data")
 	}
+	linode.RootPass = generated
 
 	return linode, nil
 }
@@ -273,3 +324,52 @@ func (c LinodeClient) ListLinodeVolumes(id uint) ([]Volume, error) {
 
 	return volumes, nil
 }
+
+// MigrateLinode starts migrating an instance to a new host, or a new region if one is given. The
+// returned Operation can be used to wait on the migration.
+func (c LinodeClient) MigrateLinode(req MigrateLinodeRequest) (Operation, error) {
+	action := EventActionLinodeMigrate
+	if req.Region != "" {
+		action = EventActionLinodeMigrateDatacenter
+	}
+
+	op, err := NewOperation(NewEventClient(c.api), req.ID, action)
+	if err != nil {
+		return op, err
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return op, errors.Wrap(err, "failed to marshal request for MigrateLinode")
+	}
+
+	if _, err := c.api.Post(fmt.Sprintf("linode/instances/%d/migrate", req.ID), payload); err != nil {
+		return op, errors.Wrap(err, "failed to make request for MigrateLinode")
+	}
+
+	return op, nil
+}
+
+// RescueLinode reboots an instance into rescue mode. The returned Operation can be used to wait
+// until it's up.
+func (c LinodeClient) RescueLinode(req RescueLinodeRequest) (Operation, error) {
+	if req.Devices.SDH != nil {
+		return Operation{}, errors.New("rescue mode boots from sdh, so it can't be mapped for RescueLinode")
+	}
+
+	op, err := NewOperation(NewEventClient(c.api), req.ID, EventActionLinodeRescue)
+	if err != nil {
+		return op, err
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return op, errors.Wrap(err, "failed to marshal request for RescueLinode")
+	}
+
+	if _, err := c.api.Post(fmt.Sprintf("linode/instances/%d/rescue", req.ID), payload); err != nil {
+		return op, errors.Wrap(err, "failed to make request for RescueLinode")
+	}
+
+	return op, nil
+}
@@ -1,6 +1,7 @@
 package lingo_test
 
 import (
+	"context"
 	"log"
 	"os"
 	"testing"
@@ -15,17 +16,17 @@ func Test_Integration_Linodes(t *testing.T) {
 	client := lingo.NewLinodeClient(api)
 
 	createLinode1 := lingo.CreateLinodeRequest{
-		Region:   "us-east-1a",
-		Type:     "g5-nanode-1",
-		Image:    "linode/debian9",
-		RootPass: "test123",
+		Region:           "us-east-1a",
+		Type:             "g5-nanode-1",
+		Image:            "linode/debian9",
+		GenerateRootPass: true,
 	}
 
 	createLinode2 := lingo.CreateLinodeRequest{
-		Region:   "us-west",
-		Type:     "g5-nanode-1",
-		Image:    "linode/debian9",
-		RootPass: "test123",
+		Region:           "us-west",
+		Type:             "g5-nanode-1",
+		Image:            "linode/debian9",
+		GenerateRootPass: true,
 	}
 
 	created1, err := client.CreateLinode(createLinode1)
@@ -93,11 +94,11 @@ func Test_BootLinode(t *testing.T) {
 	client := lingo.NewLinodeClient(api)
 
 	createLinode := lingo.CreateLinodeRequest{
-		Region:   "us-east-1a",
-		Type:     "g5-nanode-1",
-		Image:    "linode/debian9",
-		RootPass: "test123",
-		Booted:   true,
+		Region:           "us-east-1a",
+		Type:             "g5-nanode-1",
+		Image:            "linode/debian9",
+		GenerateRootPass: true,
+		Booted:           true,
 	}
 
 	testLinode, err := client.CreateLinode(createLinode)
@@ -135,11 +136,11 @@ func Test_ResizeLinode(t *testing.T) {
 
 	newType := "g5-standard-1"
 	createLinode := lingo.CreateLinodeRequest{
-		Region:   "us-east-1a",
-		Type:     "g5-nanode-1",
-		Image:    "linode/debian9",
-		RootPass: "test123",
-		Booted:   true,
+		Region:           "us-east-1a",
+		Type:             "g5-nanode-1",
+		Image:            "linode/debian9",
+		GenerateRootPass: true,
+		Booted:           true,
 	}
 
 	testLinode, err := client.CreateLinode(createLinode)
@@ -149,7 +150,14 @@ func Test_ResizeLinode(t *testing.T) {
 
 	waitUntilRunning(client, testLinode.ID)
 
-	if err := client.ResizeLinode(testLinode.ID, newType); err != nil {
+	resizeRequest := lingo.ResizeLinodeRequest{
+		ID:                  testLinode.ID,
+		Type:                newType,
+		AllowAutoDiskResize: true,
+		MigrationType:       lingo.MigrationTypeWarm,
+	}
+
+	if _, err := client.ResizeLinode(resizeRequest); err != nil {
 		t.Fatalf("Failed to resize linode: %s", err)
 	}
 
@@ -164,11 +172,11 @@ func Test_CloneLinode(t *testing.T) {
 	client := lingo.NewLinodeClient(api)
 
 	createLinode := lingo.CreateLinodeRequest{
-		Region:   "us-east-1a",
-		Type:     "g5-nanode-1",
-		Image:    "linode/debian9",
-		RootPass: "test123",
-		Booted:   true,
+		Region:           "us-east-1a",
+		Type:             "g5-nanode-1",
+		Image:            "linode/debian9",
+		GenerateRootPass: true,
+		Booted:           true,
 	}
 
 	log.Println("Creating linode to clone...")
@@ -206,11 +214,11 @@ func Test_RebuildLinode(t *testing.T) {
 	client := lingo.NewLinodeClient(api)
 
 	createLinode := lingo.CreateLinodeRequest{
-		Region:   "us-east-1a",
-		Type:     "g5-nanode-1",
-		Image:    "linode/debian9",
-		RootPass: "test123",
-		Booted:   true,
+		Region:           "us-east-1a",
+		Type:             "g5-nanode-1",
+		Image:            "linode/debian9",
+		GenerateRootPass: true,
+		Booted:           true,
 	}
 
 	log.Println("Creating linode to rebuild...")
@@ -220,9 +228,9 @@ func Test_RebuildLinode(t *testing.T) {
 	}
 
 	rebuildRequest := lingo.RebuildLinodeRequest{
-		ID:       testLinode.ID,
-		Image:    "linode/centos7",
-		RootPass: "test123",
+		ID:               testLinode.ID,
+		Image:            "linode/centos7",
+		GenerateRootPass: true,
 	}
 
 	waitUntilRunning(client, testLinode.ID)
@@ -237,6 +245,55 @@ func Test_RebuildLinode(t *testing.T) {
 	}
 
 }
+
+func Test_RescueLinode(t *testing.T) {
+	apiKey := os.Getenv("LINODE_API_KEY")
+	api := lingo.NewAPIClient(apiKey, nil)
+	client := lingo.NewLinodeClient(api)
+	diskClient := lingo.NewDiskClient(api)
+
+	testLinode, err := client.CreateLinode(lingo.CreateLinodeRequest{
+		Region:   "us-east",
+		Type:     "g6-nanode-1",
+		Image:    "linode/debian12",
+		RootPass: "test123-Lingo!",
+		Booted:   true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create linode: %s", err)
+	}
+	defer client.DeleteLinode(testLinode.ID)
+
+	waitUntilRunning(client, testLinode.ID)
+
+	disks, err := diskClient.ListDisks(testLinode.ID)
+	if err != nil || len(disks) == 0 {
+		t.Fatalf("Failed to list disks: %v", err)
+	}
+
+	op, err := client.RescueLinode(lingo.RescueLinodeRequest{
+		ID:      testLinode.ID,
+		Devices: lingo.ConfigDevices{SDA: &lingo.ConfigDevice{DiskID: disks[0].ID}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to rescue linode: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	event, err := op.Wait(ctx, func(e lingo.Event) {
+		log.Printf("Rescue %s: %d%%", e.Status, e.PercentComplete)
+	})
+	if err != nil {
+		t.Fatalf("Failed to wait on rescue: %s", err)
+	}
+
+	if event.Action != lingo.EventActionLinodeRescue {
+		t.Fatalf("Expected a rescue event, but got %s", event.Action)
+	}
+}
+
 func waitUntilRunning(client lingo.LinodeClient, id uint) error {
 	return waitUntil(client, id, lingo.StatusRunning)
 }
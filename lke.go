@@ -0,0 +1,147 @@
+package lingo
+
+// An LKEClusterStatus is an enumeration of possible LKE cluster statuses.
+type LKEClusterStatus string
+
+// Enum values for LKEClusterStatus.
+const (
+	LKEClusterStatusReady    = LKEClusterStatus("ready")
+	LKEClusterStatusNotReady = LKEClusterStatus("not_ready")
+)
+
+// An LKENodeStatus is an enumeration of possible statuses of a node in an LKE node pool.
+type LKENodeStatus string
+
+// Enum values for LKENodeStatus.
+const (
+	LKENodeStatusReady    = LKENodeStatus("ready")
+	LKENodeStatusNotReady = LKENodeStatus("not_ready")
+)
+
+// An LKEVersion represents a Kubernetes version available for LKE clusters.
+type LKEVersion struct {
+	ID string `json:"id"`
+}
+
+// An LKEControlPlane describes the control plane settings for an LKE cluster.
+type LKEControlPlane struct {
+	HighAvailability bool `json:"high_availability"`
+}
+
+// An LKECluster represents a Linode Kubernetes Engine cluster.
+type LKECluster struct {
+	ID           uint             `json:"id"`
+	Label        string           `json:"label"`
+	Region       string           `json:"region"`
+	K8sVersion   string           `json:"k8s_version"`
+	Status       LKEClusterStatus `json:"status"`
+	ControlPlane LKEControlPlane  `json:"control_plane"`
+	Tags         []string         `json:"tags"`
+	Created      Time             `json:"created"`
+	Updated      Time             `json:"updated"`
+}
+
+// An LKEAutoscaler describes the bounds an LKE node pool is allowed to scale between.
+type LKEAutoscaler struct {
+	Enabled bool `json:"enabled"`
+	Min     uint `json:"min"`
+	Max     uint `json:"max"`
+}
+
+// An LKENode represents a single Linode instance that belongs to an LKE node pool.
+type LKENode struct {
+	ID         string        `json:"id"`
+	InstanceID uint          `json:"instance_id"`
+	Status     LKENodeStatus `json:"status"`
+}
+
+// An LKENodePool represents a group of identically sized nodes in an LKE cluster.
+type LKENodePool struct {
+	ID         uint          `json:"id"`
+	Type       string        `json:"type"`
+	Count      uint          `json:"count"`
+	Nodes      []LKENode     `json:"nodes"`
+	Autoscaler LKEAutoscaler `json:"autoscaler"`
+}
+
+// A CreateLKEClusterRequest is a parameter struct for specifying a new LKE cluster.
+type CreateLKEClusterRequest struct {
+	Label        string                     `json:"label"`
+	Region       string                     `json:"region"`
+	K8sVersion   string                     `json:"k8s_version"`
+	NodePools    []CreateLKENodePoolRequest `json:"node_pools"`
+	ControlPlane *LKEControlPlane           `json:"control_plane,omitempty"`
+	Tags         []string                   `json:"tags,omitempty"`
+}
+
+// An UpdateLKEClusterRequest is a parameter struct for specifying how to update an existing LKE
+// cluster. Tags are left alone unless set, and TagList() with no tags clears them.
+type UpdateLKEClusterRequest struct {
+	ID           uint             `json:"-"`
+	Label        string           `json:"label,omitempty"`
+	K8sVersion   string           `json:"k8s_version,omitempty"`
+	ControlPlane *LKEControlPlane `json:"control_plane,omitempty"`
+	Tags         *[]string        `json:"tags,omitempty"`
+}
+
+// A CreateLKENodePoolRequest is a parameter struct for specifying a new node pool. The ClusterID
+// is ignored when the request is used as part of a CreateLKEClusterRequest.
+type CreateLKENodePoolRequest struct {
+	ClusterID  uint           `json:"-"`
+	Type       string         `json:"type"`
+	Count      uint           `json:"count"`
+	Autoscaler *LKEAutoscaler `json:"autoscaler,omitempty"`
+}
+
+// An UpdateLKENodePoolRequest is a parameter struct for resizing an existing node pool or changing
+// its autoscaler bounds.
+type UpdateLKENodePoolRequest struct {
+	ClusterID  uint           `json:"-"`
+	ID         uint           `json:"-"`
+	Count      uint           `json:"count,omitempty"`
+	Autoscaler *LKEAutoscaler `json:"autoscaler,omitempty"`
+}
+
+// A Kuberneter works with Linode Kubernetes Engine clusters and node pools.
+type Kuberneter interface {
+	ListLKEVersions() ([]LKEVersion, error)
+
+	ListLKEClusters() ([]LKECluster, error)
+	ListLKEClustersByTag(tag string) ([]LKECluster, error)
+	ViewLKECluster(id uint) (LKECluster, error)
+	CreateLKECluster(req CreateLKEClusterRequest) (LKECluster, error)
+	UpdateLKECluster(req UpdateLKEClusterRequest) (LKECluster, error)
+	DeleteLKECluster(id uint) error
+	RecycleLKECluster(id uint) error
+	ViewLKEKubeconfig(id uint) ([]byte, error)
+
+	ListLKENodePools(clusterID uint) ([]LKENodePool, error)
+	ViewLKENodePool(clusterID, poolID uint) (LKENodePool, error)
+	CreateLKENodePool(req CreateLKENodePoolRequest) (LKENodePool, error)
+	UpdateLKENodePool(req UpdateLKENodePoolRequest) (LKENodePool, error)
+	DeleteLKENodePool(clusterID, poolID uint) error
+	RecycleLKENodePool(clusterID, poolID uint) error
+
+	DeleteLKENode(clusterID uint, nodeID string) error
+	RecycleLKENode(clusterID uint, nodeID string) error
+}
+
+// ValidateLKEClusterStatus validates whether or not a test string is an LKEClusterStatus enum.
+func ValidateLKEClusterStatus(test string) bool {
+	switch LKEClusterStatus(test) {
+	case LKEClusterStatusReady, LKEClusterStatusNotReady:
+		return true
+	default:
+		return false
+	}
+}
+
+// ValidateLKENodeStatus validates whether or not a test string is an LKENodeStatus enum.
+func ValidateLKENodeStatus(test string) bool {
+	switch LKENodeStatus(test) {
+	case LKENodeStatusReady, LKENodeStatusNotReady:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,335 @@
+package lingo
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// An LKEClient implements the Kuberneter interface and provides all of the functionality for
+// managing Linode Kubernetes Engine clusters.
+type LKEClient struct {
+	api APIClient
+}
+
+// NewLKEClient returns a new LKEClient given a valid APIClient.
+func NewLKEClient(api APIClient) LKEClient {
+	return LKEClient{api: api}
+}
+
+// ListLKEVersions retrieves a slice of the Kubernetes versions available for new LKE clusters.
+func (c LKEClient) ListLKEVersions() ([]LKEVersion, error) {
+	data, err := c.api.Get("lke/versions")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make request for ListLKEVersions")
+	}
+
+	var results Results
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, errors.Wrap(err, "failed to decode ListLKEVersions response")
+	}
+
+	var versions []LKEVersion
+	if err := json.Unmarshal(results.Data, &versions); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal ListLKEVersions data")
+	}
+
+	return versions, nil
+}
+
+// ListLKEClusters retrieves a slice of the LKE clusters in a Linode account.
+func (c LKEClient) ListLKEClusters() ([]LKECluster, error) {
+	data, err := c.api.Get("lke/clusters")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make request for ListLKEClusters")
+	}
+
+	var results Results
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, errors.Wrap(err, "failed to decode ListLKEClusters response")
+	}
+
+	var clusters []LKECluster
+	if err := json.Unmarshal(results.Data, &clusters); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal ListLKEClusters data")
+	}
+
+	return clusters, nil
+}
+
+// ListLKEClustersByTag retrieves a slice of the LKE clusters carrying the given tag.
+func (c LKEClient) ListLKEClustersByTag(tag string) ([]LKECluster, error) {
+	data, err := c.api.GetFiltered("lke/clusters", TagFilter(tag))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make request for ListLKEClustersByTag")
+	}
+
+	var results Results
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, errors.Wrap(err, "failed to decode ListLKEClustersByTag response")
+	}
+
+	var clusters []LKECluster
+	if err := json.Unmarshal(results.Data, &clusters); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal ListLKEClustersByTag data")
+	}
+
+	return clusters, nil
+}
+
+// ViewLKECluster retrieves a specific LKE cluster.
+func (c LKEClient) ViewLKECluster(id uint) (LKECluster, error) {
+	var cluster LKECluster
+
+	data, err := c.api.Get(fmt.Sprintf("lke/clusters/%d", id))
+	if err != nil {
+		return cluster, errors.Wrap(err, "failed to make request for ViewLKECluster")
+	}
+
+	if err := json.Unmarshal(data, &cluster); err != nil {
+		return cluster, errors.Wrap(err, "failed to unmarshal ViewLKECluster data")
+	}
+
+	return cluster, nil
+}
+
+// CreateLKECluster creates a new LKE cluster along with its initial node pools.
+func (c LKEClient) CreateLKECluster(req CreateLKEClusterRequest) (LKECluster, error) {
+	var cluster LKECluster
+
+	payload, err := json.Marshal(&req)
+	if err != nil {
+		return cluster, errors.Wrap(err, "failed to marshal request for CreateLKECluster")
+	}
+
+	data, err := c.api.Post("lke/clusters", payload)
+	if err != nil {
+		return cluster, errors.Wrap(err, "failed to make request for CreateLKECluster")
+	}
+
+	if err := json.Unmarshal(data, &cluster); err != nil {
+		return cluster, errors.Wrap(err, "failed to decode CreateLKECluster response")
+	}
+
+	return cluster, nil
+}
+
+// UpdateLKECluster updates a specific LKE cluster if it exists.
+func (c LKEClient) UpdateLKECluster(req UpdateLKEClusterRequest) (LKECluster, error) {
+	var cluster LKECluster
+
+	payload, err := json.Marshal(&req)
+	if err != nil {
+		return cluster, errors.Wrap(err, "failed to marshal request for UpdateLKECluster")
+	}
+
+	data, err := c.api.Put(fmt.Sprintf("lke/clusters/%d", req.ID), payload)
+	if err != nil {
+		return cluster, errors.Wrap(err, "failed to make request for UpdateLKECluster")
+	}
+
+	if err := json.Unmarshal(data, &cluster); err != nil {
+		return cluster, errors.Wrap(err, "failed to decode UpdateLKECluster response")
+	}
+
+	return cluster, nil
+}
+
+// DeleteLKECluster deletes a specific LKE cluster along with all of its node pools.
+func (c LKEClient) DeleteLKECluster(id uint) error {
+	if _, err := c.api.Delete(fmt.Sprintf("lke/clusters/%d", id)); err != nil {
+		return errors.Wrap(err, "failed to make request for DeleteLKECluster")
+	}
+
+	return nil
+}
+
+// RecycleLKECluster replaces every node in every node pool of an LKE cluster.
+func (c LKEClient) RecycleLKECluster(id uint) error {
+	if _, err := c.api.Post(fmt.Sprintf("lke/clusters/%d/recycle", id), nil); err != nil {
+		return errors.Wrap(err, "failed to make request for RecycleLKECluster")
+	}
+
+	return nil
+}
+
+// ViewLKEKubeconfig retrieves the kubeconfig for an LKE cluster, already decoded from the base64
+// encoding the API returns it in.
+func (c LKEClient) ViewLKEKubeconfig(id uint) ([]byte, error) {
+	data, err := c.api.Get(fmt.Sprintf("lke/clusters/%d/kubeconfig", id))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make request for ViewLKEKubeconfig")
+	}
+
+	var kubeconfig struct {
+		Kubeconfig string `json:"kubeconfig"`
+	}
+
+	if err := json.Unmarshal(data, &kubeconfig); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal ViewLKEKubeconfig data")
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(kubeconfig.Kubeconfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode kubeconfig")
+	}
+
+	return decoded, nil
+}
+
+// ListLKENodePools retrieves a slice of the node pools in an LKE cluster.
+func (c LKEClient) ListLKENodePools(clusterID uint) ([]LKENodePool, error) {
+	data, err := c.api.Get(fmt.Sprintf("lke/clusters/%d/pools", clusterID))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make request for ListLKENodePools")
+	}
+
+	var results Results
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, errors.Wrap(err, "failed to decode ListLKENodePools response")
+	}
+
+	var pools []LKENodePool
+	if err := json.Unmarshal(results.Data, &pools); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal ListLKENodePools data")
+	}
+
+	return pools, nil
+}
+
+// ViewLKENodePool retrieves a specific node pool in an LKE cluster.
+func (c LKEClient) ViewLKENodePool(clusterID, poolID uint) (LKENodePool, error) {
+	var pool LKENodePool
+
+	data, err := c.api.Get(fmt.Sprintf("lke/clusters/%d/pools/%d", clusterID, poolID))
+	if err != nil {
+		return pool, errors.Wrap(err, "failed to make request for ViewLKENodePool")
+	}
+
+	if err := json.Unmarshal(data, &pool); err != nil {
+		return pool, errors.Wrap(err, "failed to unmarshal ViewLKENodePool data")
+	}
+
+	return pool, nil
+}
+
+// CreateLKENodePool adds a new node pool to an existing LKE cluster.
+func (c LKEClient) CreateLKENodePool(req CreateLKENodePoolRequest) (LKENodePool, error) {
+	var pool LKENodePool
+
+	payload, err := json.Marshal(&req)
+	if err != nil {
+		return pool, errors.Wrap(err, "failed to marshal request for CreateLKENodePool")
+	}
+
+	data, err := c.api.Post(fmt.Sprintf("lke/clusters/%d/pools", req.ClusterID), payload)
+	if err != nil {
+		return pool, errors.Wrap(err, "failed to make request for CreateLKENodePool")
+	}
+
+	if err := json.Unmarshal(data, &pool); err != nil {
+		return pool, errors.Wrap(err, "failed to decode CreateLKENodePool response")
+	}
+
+	return pool, nil
+}
+
+// UpdateLKENodePool resizes a node pool or changes its autoscaler bounds.
+func (c LKEClient) UpdateLKENodePool(req UpdateLKENodePoolRequest) (LKENodePool, error) {
+	var pool LKENodePool
+
+	payload, err := json.Marshal(&req)
+	if err != nil {
+		return pool, errors.Wrap(err, "failed to marshal request for UpdateLKENodePool")
+	}
+
+	data, err := c.api.Put(fmt.Sprintf("lke/clusters/%d/pools/%d", req.ClusterID, req.ID), payload)
+	if err != nil {
+		return pool, errors.Wrap(err, "failed to make request for UpdateLKENodePool")
+	}
+
+	if err := json.Unmarshal(data, &pool); err != nil {
+		return pool, errors.Wrap(err, "failed to decode UpdateLKENodePool response")
+	}
+
+	return pool, nil
+}
+
+// DeleteLKENodePool deletes a specific node pool and all of its nodes.
+func (c LKEClient) DeleteLKENodePool(clusterID, poolID uint) error {
+	if _, err := c.api.Delete(fmt.Sprintf("lke/clusters/%d/pools/%d", clusterID, poolID)); err != nil {
+		return errors.Wrap(err, "failed to make request for DeleteLKENodePool")
+	}
+
+	return nil
+}
+
+// RecycleLKENodePool replaces every node in a specific node pool.
+func (c LKEClient) RecycleLKENodePool(clusterID, poolID uint) error {
+	if _, err := c.api.Post(fmt.Sprintf("lke/clusters/%d/pools/%d/recycle", clusterID, poolID), nil); err != nil {
+		return errors.Wrap(err, "failed to make request for RecycleLKENodePool")
+	}
+
+	return nil
+}
+
+// DeleteLKENode deletes a single node from its node pool. The pool's count is reduced by one.
+func (c LKEClient) DeleteLKENode(clusterID uint, nodeID string) error {
+	if _, err := c.api.Delete(fmt.Sprintf("lke/clusters/%d/nodes/%s", clusterID, nodeID)); err != nil {
+		return errors.Wrap(err, "failed to make request for DeleteLKENode")
+	}
+
+	return nil
+}
+
+// RecycleLKENode replaces a single node in an LKE cluster.
+func (c LKEClient) RecycleLKENode(clusterID uint, nodeID string) error {
+	if _, err := c.api.Post(fmt.Sprintf("lke/clusters/%d/nodes/%s/recycle", clusterID, nodeID), nil); err != nil {
+		return errors.Wrap(err, "failed to make request for RecycleLKENode")
+	}
+
+	return nil
+}
+
+// WaitForLKENodesReady polls the node pools of an LKE cluster every interval until every node in
+// every pool reports ready, or until the timeout has elapsed.
+func (c LKEClient) WaitForLKENodesReady(clusterID uint, interval, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		pools, err := c.ListLKENodePools(clusterID)
+		if err != nil {
+			return err
+		}
+
+		if lkeNodesReady(pools) {
+			return nil
+		}
+
+		if time.Now().Add(interval).After(deadline) {
+			return errors.Errorf("timed out waiting for nodes in LKE cluster %d to be ready", clusterID)
+		}
+
+		time.Sleep(interval)
+	}
+}
+
+// lkeNodesReady reports whether every pool has its full count of nodes and all of them are ready.
+func lkeNodesReady(pools []LKENodePool) bool {
+	for _, pool := range pools {
+		if uint(len(pool.Nodes)) < pool.Count {
+			return false
+		}
+
+		for _, node := range pool.Nodes {
+			if node.Status != LKENodeStatusReady {
+				return false
+			}
+		}
+	}
+
+	return true
+}
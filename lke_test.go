@@ -0,0 +1,137 @@
+package lingo_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eriktate/lingo"
+)
+
+func Test_LKEVersions(t *testing.T) {
+	apiKey := os.Getenv("LINODE_API_KEY")
+	api := lingo.NewAPIClient(apiKey, nil)
+	client := lingo.NewLKEClient(api)
+
+	versions, err := client.ListLKEVersions()
+	if err != nil {
+		t.Fatalf("Failed to list LKE versions: %s", err)
+	}
+
+	if len(versions) == 0 {
+		t.Fatal("Failed to retrieve any LKE versions")
+	}
+}
+
+func Test_CRUDLKECluster(t *testing.T) {
+	apiKey := os.Getenv("LINODE_API_KEY")
+	api := lingo.NewAPIClient(apiKey, nil)
+	client := lingo.NewLKEClient(api)
+
+	versions, err := client.ListLKEVersions()
+	if err != nil || len(versions) == 0 {
+		t.Fatalf("Failed to list LKE versions: %s", err)
+	}
+
+	createCluster := lingo.CreateLKEClusterRequest{
+		Label:      "lingo-test-cluster",
+		Region:     "us-east",
+		K8sVersion: versions[0].ID,
+		NodePools: []lingo.CreateLKENodePoolRequest{
+			{Type: "g6-standard-1", Count: 1},
+		},
+	}
+
+	cluster, err := client.CreateLKECluster(createCluster)
+	if err != nil {
+		t.Fatalf("Failed to create LKE cluster: %s", err)
+	}
+
+	if err := client.WaitForLKENodesReady(cluster.ID, 15*time.Second, 15*time.Minute); err != nil {
+		t.Fatalf("Failed waiting for LKE nodes: %s", err)
+	}
+
+	kubeconfig, err := client.ViewLKEKubeconfig(cluster.ID)
+	if err != nil {
+		t.Fatalf("Failed to view kubeconfig: %s", err)
+	}
+
+	if !strings.Contains(string(kubeconfig), "apiVersion") {
+		t.Fatal("Kubeconfig wasn't decoded")
+	}
+
+	createPool := lingo.CreateLKENodePoolRequest{
+		ClusterID: cluster.ID,
+		Type:      "g6-standard-1",
+		Count:     1,
+	}
+
+	pool, err := client.CreateLKENodePool(createPool)
+	if err != nil {
+		t.Fatalf("Failed to create node pool: %s", err)
+	}
+
+	updatePool := lingo.UpdateLKENodePoolRequest{
+		ClusterID: cluster.ID,
+		ID:        pool.ID,
+		Count:     2,
+		Autoscaler: &lingo.LKEAutoscaler{
+			Enabled: true,
+			Min:     2,
+			Max:     4,
+		},
+	}
+
+	if _, err := client.UpdateLKENodePool(updatePool); err != nil {
+		t.Fatalf("Failed to update node pool: %s", err)
+	}
+
+	getPool, err := client.ViewLKENodePool(cluster.ID, pool.ID)
+	if err != nil {
+		t.Fatalf("Failed to view node pool: %s", err)
+	}
+
+	if getPool.Count != updatePool.Count || !getPool.Autoscaler.Enabled {
+		t.Fatal("Update of node pool didn't actually occur")
+	}
+
+	if err := client.RecycleLKENodePool(cluster.ID, pool.ID); err != nil {
+		t.Fatalf("Failed to recycle node pool: %s", err)
+	}
+
+	pools, err := client.ListLKENodePools(cluster.ID)
+	if err != nil {
+		t.Fatalf("Failed to list node pools: %s", err)
+	}
+
+	if len(pools) != 2 {
+		t.Fatalf("Something strange happened. Expected to list 2 node pools, but got %d", len(pools))
+	}
+
+	updateCluster := lingo.UpdateLKEClusterRequest{
+		ID:    cluster.ID,
+		Label: "lingo-test-updated",
+	}
+
+	if _, err := client.UpdateLKECluster(updateCluster); err != nil {
+		t.Fatalf("Failed to update LKE cluster: %s", err)
+	}
+
+	getCluster, err := client.ViewLKECluster(cluster.ID)
+	if err != nil {
+		t.Fatalf("Failed to view LKE cluster: %s", err)
+	}
+
+	if getCluster.Label != updateCluster.Label {
+		t.Fatal("Update of LKE cluster didn't actually occur")
+	}
+
+	if err := client.DeleteLKENodePool(cluster.ID, pool.ID); err != nil {
+		t.Fatalf("Failed to delete node pool: %s", err)
+	}
+
+	if err := client.DeleteLKECluster(cluster.ID); err != nil {
+		t.Fatalf("Failed to delete LKE cluster: %s", err)
+	}
+}
@@ -0,0 +1,203 @@
+package lingo
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// LongviewApps reports which application specific plugins a Longview client has detected.
+type LongviewApps struct {
+	Apache bool `json:"apache"`
+	Nginx  bool `json:"nginx"`
+	MySQL  bool `json:"mysql"`
+}
+
+// A LongviewHost represents a Longview client, which is a single host reporting metrics to
+// Longview. It's named LongviewHost so it doesn't collide with LongviewClient.
+type LongviewHost struct {
+	ID          uint         `json:"id"`
+	Label       string       `json:"label"`
+	APIKey      string       `json:"api_key"`
+	InstallCode string       `json:"install_code"`
+	Apps        LongviewApps `json:"apps"`
+	Created     Time         `json:"created"`
+	Updated     Time         `json:"updated"`
+}
+
+// A CreateLongviewHostRequest is a parameter struct for registering a new Longview client.
+type CreateLongviewHostRequest struct {
+	Label string `json:"label,omitempty"`
+}
+
+// An UpdateLongviewHostRequest is a parameter struct for renaming an existing Longview client.
+type UpdateLongviewHostRequest struct {
+	ID    uint   `json:"-"`
+	Label string `json:"label"`
+}
+
+// A LongviewSubscription represents a Longview Pro plan.
+type LongviewSubscription struct {
+	ID              string `json:"id"`
+	Label           string `json:"label"`
+	ClientsIncluded uint   `json:"clients_included"`
+	Price           Price  `json:"price"`
+}
+
+// A LongviewPlan describes the Longview subscription an account is currently on. An empty
+// LongviewSubscription means the account is on the free plan.
+type LongviewPlan struct {
+	LongviewSubscription string `json:"longview_subscription"`
+}
+
+// A LongviewSample is a single data point reported by a Longview client.
+type LongviewSample struct {
+	Time  time.Time
+	Value float64
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for LongviewSamples, which Longview
+// encodes as {"x": <unix seconds>, "y": <value>}.
+func (s *LongviewSample) UnmarshalJSON(data []byte) error {
+	var point struct {
+		X float64 `json:"x"`
+		Y float64 `json:"y"`
+	}
+
+	if err := json.Unmarshal(data, &point); err != nil {
+		return err
+	}
+
+	s.Time = time.Unix(int64(point.X), 0).UTC()
+	s.Value = point.Y
+	return nil
+}
+
+// LongviewCPU holds the samples for a single CPU core, as percentages.
+type LongviewCPU struct {
+	User   []LongviewSample `json:"user"`
+	Nice   []LongviewSample `json:"nice"`
+	System []LongviewSample `json:"system"`
+	Wait   []LongviewSample `json:"wait"`
+}
+
+// LongviewMemory holds the samples for physical memory and swap, in kilobytes.
+type LongviewMemory struct {
+	Real struct {
+		Used    []LongviewSample `json:"used"`
+		Free    []LongviewSample `json:"free"`
+		Buffers []LongviewSample `json:"buffers"`
+		Cache   []LongviewSample `json:"cache"`
+	} `json:"real"`
+	Swap struct {
+		Used []LongviewSample `json:"used"`
+		Free []LongviewSample `json:"free"`
+	} `json:"swap"`
+}
+
+// LongviewFilesystem holds the samples for the filesystem mounted from a disk.
+type LongviewFilesystem struct {
+	Path   string           `json:"path"`
+	Free   []LongviewSample `json:"free"`
+	Total  []LongviewSample `json:"total"`
+	IFree  []LongviewSample `json:"ifree"`
+	ITotal []LongviewSample `json:"itotal"`
+}
+
+// LongviewDisk holds the IO samples for a single block device.
+type LongviewDisk struct {
+	Reads      []LongviewSample   `json:"reads"`
+	Writes     []LongviewSample   `json:"writes"`
+	ReadBytes  []LongviewSample   `json:"read_bytes"`
+	WriteBytes []LongviewSample   `json:"write_bytes"`
+	FS         LongviewFilesystem `json:"fs"`
+}
+
+// LongviewInterface holds the traffic samples for a single network interface, in bytes.
+type LongviewInterface struct {
+	RxBytes []LongviewSample `json:"rx_bytes"`
+	TxBytes []LongviewSample `json:"tx_bytes"`
+}
+
+// LongviewProcessUsage holds the resource samples for a process running as a single user.
+type LongviewProcessUsage struct {
+	CPU           []LongviewSample `json:"cpu"`
+	Mem           []LongviewSample `json:"mem"`
+	Count         []LongviewSample `json:"count"`
+	IOReadKBytes  []LongviewSample `json:"ioreadkbytes"`
+	IOWriteKBytes []LongviewSample `json:"iowritekbytes"`
+}
+
+// A LongviewProcess holds the resource samples for a named process, keyed by the user it runs as.
+type LongviewProcess struct {
+	LongName string
+	Users    map[string]LongviewProcessUsage
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for LongviewProcesses. Longview mixes the
+// process' long name in with the per user objects, so they have to be separated by hand.
+func (p *LongviewProcess) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	p.Users = make(map[string]LongviewProcessUsage)
+	for name, raw := range fields {
+		if name == "longname" {
+			if err := json.Unmarshal(raw, &p.LongName); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		var usage LongviewProcessUsage
+		if err := json.Unmarshal(raw, &usage); err != nil {
+			return err
+		}
+
+		p.Users[name] = usage
+	}
+
+	return nil
+}
+
+// LongviewData is the decoded set of metrics returned by the Longview data endpoint. Only the
+// sections that were requested will be populated.
+type LongviewData struct {
+	CPU     map[string]LongviewCPU  `json:"CPU"`
+	Memory  LongviewMemory          `json:"Memory"`
+	Disk    map[string]LongviewDisk `json:"Disk"`
+	Network struct {
+		Interface map[string]LongviewInterface `json:"Interface"`
+	} `json:"Network"`
+	Processes map[string]LongviewProcess `json:"Processes"`
+	Load      []LongviewSample           `json:"Load"`
+	Uptime    float64                    `json:"Uptime"`
+}
+
+// A LongviewDataRequest is a parameter struct for fetching metrics from a Longview client. Leaving
+// Start zero fetches only the latest values, and leaving Keys empty fetches every section. When Start
+// is set, End defaults to now and can't come before Start.
+type LongviewDataRequest struct {
+	APIKey string
+	Keys   []string
+	Start  time.Time
+	End    time.Time
+}
+
+// A Longviewer works with Longview clients, subscriptions and metrics.
+type Longviewer interface {
+	ListLongviewHosts() ([]LongviewHost, error)
+	ViewLongviewHost(id uint) (LongviewHost, error)
+	CreateLongviewHost(req CreateLongviewHostRequest) (LongviewHost, error)
+	UpdateLongviewHost(req UpdateLongviewHostRequest) (LongviewHost, error)
+	DeleteLongviewHost(id uint) error
+
+	ListLongviewSubscriptions() ([]LongviewSubscription, error)
+	ViewLongviewSubscription(id string) (LongviewSubscription, error)
+	ViewLongviewPlan() (LongviewPlan, error)
+	UpdateLongviewPlan(plan LongviewPlan) (LongviewPlan, error)
+
+	FetchLongviewData(req LongviewDataRequest) (LongviewData, error)
+}
@@ -0,0 +1,291 @@
+package lingo
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// The Longview data endpoint lives outside of the v4 API.
+const longviewFetchURI = "https://longview.linode.com/fetch"
+
+// The sections fetched when a LongviewDataRequest doesn't ask for specific keys.
+var defaultLongviewKeys = []string{"CPU.*", "Memory.*", "Disk.*", "Network.*", "Processes.*", "Load.*", "Uptime"}
+
+// A LongviewClient implements the Longviewer interface and provides all of the functionality for
+// managing Longview clients and retrieving the metrics they report.
+type LongviewClient struct {
+	api      APIClient
+	fetchURI string
+	h        *http.Client
+}
+
+// NewLongviewClient returns a new LongviewClient given a valid APIClient.
+func NewLongviewClient(api APIClient) LongviewClient {
+	return NewLongviewClientWithFetchURI(api, longviewFetchURI)
+}
+
+// NewLongviewClientWithFetchURI returns a new LongviewClient that fetches metrics from an
+// alternative Longview data endpoint.
+func NewLongviewClientWithFetchURI(api APIClient, fetchURI string) LongviewClient {
+	return LongviewClient{
+		api:      api,
+		fetchURI: fetchURI,
+		h:        http.DefaultClient,
+	}
+}
+
+// ListLongviewHosts retrieves a slice of the Longview clients in a Linode account.
+func (c LongviewClient) ListLongviewHosts() ([]LongviewHost, error) {
+	data, err := c.api.Get("longview/clients")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make request for ListLongviewHosts")
+	}
+
+	var results Results
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, errors.Wrap(err, "failed to decode ListLongviewHosts response")
+	}
+
+	var hosts []LongviewHost
+	if err := json.Unmarshal(results.Data, &hosts); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal ListLongviewHosts data")
+	}
+
+	return hosts, nil
+}
+
+// ViewLongviewHost retrieves a specific Longview client, including its install code and API key.
+func (c LongviewClient) ViewLongviewHost(id uint) (LongviewHost, error) {
+	var host LongviewHost
+
+	data, err := c.api.Get(fmt.Sprintf("longview/clients/%d", id))
+	if err != nil {
+		return host, errors.Wrap(err, "failed to make request for ViewLongviewHost")
+	}
+
+	if err := json.Unmarshal(data, &host); err != nil {
+		return host, errors.Wrap(err, "failed to unmarshal ViewLongviewHost data")
+	}
+
+	return host, nil
+}
+
+// CreateLongviewHost registers a new Longview client. The returned install code is what gets passed
+// to the Longview installer on the host.
+func (c LongviewClient) CreateLongviewHost(req CreateLongviewHostRequest) (LongviewHost, error) {
+	var host LongviewHost
+
+	payload, err := json.Marshal(&req)
+	if err != nil {
+		return host, errors.Wrap(err, "failed to marshal request for CreateLongviewHost")
+	}
+
+	data, err := c.api.Post("longview/clients", payload)
+	if err != nil {
+		return host, errors.Wrap(err, "failed to make request for CreateLongviewHost")
+	}
+
+	if err := json.Unmarshal(data, &host); err != nil {
+		return host, errors.Wrap(err, "failed to decode CreateLongviewHost response")
+	}
+
+	return host, nil
+}
+
+// UpdateLongviewHost renames an existing Longview client.
+func (c LongviewClient) UpdateLongviewHost(req UpdateLongviewHostRequest) (LongviewHost, error) {
+	var host LongviewHost
+
+	payload, err := json.Marshal(&req)
+	if err != nil {
+		return host, errors.Wrap(err, "failed to marshal request for UpdateLongviewHost")
+	}
+
+	data, err := c.api.Put(fmt.Sprintf("longview/clients/%d", req.ID), payload)
+	if err != nil {
+		return host, errors.Wrap(err, "failed to make request for UpdateLongviewHost")
+	}
+
+	if err := json.Unmarshal(data, &host); err != nil {
+		return host, errors.Wrap(err, "failed to decode UpdateLongviewHost response")
+	}
+
+	return host, nil
+}
+
+// DeleteLongviewHost deletes a specific Longview client. The host will stop being able to report.
+func (c LongviewClient) DeleteLongviewHost(id uint) error {
+	if _, err := c.api.Delete(fmt.Sprintf("longview/clients/%d", id)); err != nil {
+		return errors.Wrap(err, "failed to make request for DeleteLongviewHost")
+	}
+
+	return nil
+}
+
+// ListLongviewSubscriptions retrieves a slice of the available Longview Pro plans.
+func (c LongviewClient) ListLongviewSubscriptions() ([]LongviewSubscription, error) {
+	data, err := c.api.Get("longview/subscriptions")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make request for ListLongviewSubscriptions")
+	}
+
+	var results Results
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, errors.Wrap(err, "failed to decode ListLongviewSubscriptions response")
+	}
+
+	var subscriptions []LongviewSubscription
+	if err := json.Unmarshal(results.Data, &subscriptions); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal ListLongviewSubscriptions data")
+	}
+
+	return subscriptions, nil
+}
+
+// ViewLongviewSubscription retrieves a specific Longview Pro plan.
+func (c LongviewClient) ViewLongviewSubscription(id string) (LongviewSubscription, error) {
+	var subscription LongviewSubscription
+
+	data, err := c.api.Get("longview/subscriptions/" + id)
+	if err != nil {
+		return subscription, errors.Wrap(err, "failed to make request for ViewLongviewSubscription")
+	}
+
+	if err := json.Unmarshal(data, &subscription); err != nil {
+		return subscription, errors.Wrap(err, "failed to unmarshal ViewLongviewSubscription data")
+	}
+
+	return subscription, nil
+}
+
+// ViewLongviewPlan retrieves the Longview subscription the account is currently on.
+func (c LongviewClient) ViewLongviewPlan() (LongviewPlan, error) {
+	var plan LongviewPlan
+
+	data, err := c.api.Get("longview/plan")
+	if err != nil {
+		return plan, errors.Wrap(err, "failed to make request for ViewLongviewPlan")
+	}
+
+	if err := json.Unmarshal(data, &plan); err != nil {
+		return plan, errors.Wrap(err, "failed to unmarshal ViewLongviewPlan data")
+	}
+
+	return plan, nil
+}
+
+// UpdateLongviewPlan switches the account to a different Longview subscription.
+func (c LongviewClient) UpdateLongviewPlan(plan LongviewPlan) (LongviewPlan, error) {
+	var updated LongviewPlan
+
+	payload, err := json.Marshal(&plan)
+	if err != nil {
+		return updated, errors.Wrap(err, "failed to marshal request for UpdateLongviewPlan")
+	}
+
+	data, err := c.api.Put("longview/plan", payload)
+	if err != nil {
+		return updated, errors.Wrap(err, "failed to make request for UpdateLongviewPlan")
+	}
+
+	if err := json.Unmarshal(data, &updated); err != nil {
+		return updated, errors.Wrap(err, "failed to decode UpdateLongviewPlan response")
+	}
+
+	return updated, nil
+}
+
+// FetchLongviewData retrieves metrics reported by a Longview client, authenticating with the
+// client's own API key rather than the account's.
+func (c LongviewClient) FetchLongviewData(req LongviewDataRequest) (LongviewData, error) {
+	var data LongviewData
+
+	keys := req.Keys
+	if len(keys) == 0 {
+		keys = defaultLongviewKeys
+	}
+
+	encodedKeys, err := json.Marshal(keys)
+	if err != nil {
+		return data, errors.Wrap(err, "failed to marshal keys for FetchLongviewData")
+	}
+
+	form := url.Values{
+		"api_key":    {req.APIKey},
+		"api_action": {"getLatestValue"},
+		"keys":       {string(encodedKeys)},
+	}
+
+	if !req.Start.IsZero() {
+		end := req.End
+		if end.IsZero() {
+			end = time.Now()
+		}
+
+		if end.Before(req.Start) {
+			return data, errors.Errorf("FetchLongviewData end %s is before start %s", end.Format(time.RFC3339), req.Start.Format(time.RFC3339))
+		}
+
+		form.Set("api_action", "getValues")
+		form.Set("start", strconv.FormatInt(req.Start.Unix(), 10))
+		form.Set("end", strconv.FormatInt(end.Unix(), 10))
+	}
+
+	res, err := c.h.Post(c.fetchURI, "application/x-www-form-urlencoded", strings.NewReader(form.Encode()))
+	if err != nil {
+		return data, errors.Wrap(err, "failed to make request for FetchLongviewData")
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return data, errors.Wrap(err, "failed to read FetchLongviewData response")
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return data, errors.Errorf("FetchLongviewData failed with status %d: %s", res.StatusCode, string(body))
+	}
+
+	var responses []struct {
+		Action        string          `json:"ACTION"`
+		Data          json.RawMessage `json:"DATA"`
+		Notifications []struct {
+			Code     int    `json:"CODE"`
+			Severity int    `json:"SEVERITY"`
+			Text     string `json:"TEXT"`
+		} `json:"NOTIFICATIONS"`
+	}
+
+	if err := json.Unmarshal(body, &responses); err != nil {
+		return data, errors.Wrap(err, "failed to decode FetchLongviewData response")
+	}
+
+	if len(responses) == 0 {
+		return data, errors.New("FetchLongviewData returned no responses")
+	}
+
+	response := responses[0]
+	if len(response.Notifications) > 0 && (len(response.Data) == 0 || string(response.Data) == "[]") {
+		notification := response.Notifications[0]
+		return data, errors.Errorf("Longview error %d: %s", notification.Code, notification.Text)
+	}
+
+	// Longview returns an empty array rather than an empty object when there's no data.
+	if len(response.Data) == 0 || string(response.Data) == "[]" {
+		return data, nil
+	}
+
+	if err := json.Unmarshal(response.Data, &data); err != nil {
+		return data, errors.Wrap(err, "failed to unmarshal FetchLongviewData data")
+	}
+
+	return data, nil
+}
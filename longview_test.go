@@ -0,0 +1,207 @@
+package lingo_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/eriktate/lingo"
+)
+
+func Test_CRUDLongviewHost(t *testing.T) {
+	apiKey := os.Getenv("LINODE_API_KEY")
+	api := lingo.NewAPIClient(apiKey, nil)
+	client := lingo.NewLongviewClient(api)
+
+	existing, err := client.ListLongviewHosts()
+	if err != nil {
+		t.Fatalf("Failed to list longview clients: %s", err)
+	}
+
+	host, err := client.CreateLongviewHost(lingo.CreateLongviewHostRequest{Label: "lingo-test"})
+	if err != nil {
+		t.Fatalf("Failed to create longview client: %s", err)
+	}
+
+	if host.InstallCode == "" || host.APIKey == "" {
+		t.Fatal("Expected install code and API key to be returned")
+	}
+
+	updateHost := lingo.UpdateLongviewHostRequest{
+		ID:    host.ID,
+		Label: "lingo-test-updated",
+	}
+
+	if _, err := client.UpdateLongviewHost(updateHost); err != nil {
+		t.Fatalf("Failed to rename longview client: %s", err)
+	}
+
+	getHost, err := client.ViewLongviewHost(host.ID)
+	if err != nil {
+		t.Fatalf("Failed to view longview client: %s", err)
+	}
+
+	if getHost.Label != updateHost.Label {
+		t.Fatal("Update of longview client didn't actually occur")
+	}
+
+	hosts, err := client.ListLongviewHosts()
+	if err != nil {
+		t.Fatalf("Failed to list longview clients: %s", err)
+	}
+
+	expected := len(existing) + 1
+	if len(hosts) != expected {
+		t.Fatalf("Something strange happened. Expected to list %d longview clients, but got %d", expected, len(hosts))
+	}
+
+	if err := client.DeleteLongviewHost(host.ID); err != nil {
+		t.Fatalf("Failed to delete longview client: %s", err)
+	}
+}
+
+func Test_LongviewSubscriptions(t *testing.T) {
+	apiKey := os.Getenv("LINODE_API_KEY")
+	api := lingo.NewAPIClient(apiKey, nil)
+	client := lingo.NewLongviewClient(api)
+
+	subscriptions, err := client.ListLongviewSubscriptions()
+	if err != nil {
+		t.Fatalf("Failed to list longview subscriptions: %s", err)
+	}
+
+	if len(subscriptions) == 0 {
+		t.Fatal("Failed to retrieve any longview subscriptions")
+	}
+
+	subscription, err := client.ViewLongviewSubscription(subscriptions[0].ID)
+	if err != nil {
+		t.Fatalf("Failed to view longview subscription: %s", err)
+	}
+
+	if subscription.ClientsIncluded != subscriptions[0].ClientsIncluded {
+		t.Fatal("Subscription doesn't match Subscriptions slice")
+	}
+
+	if _, err := client.ViewLongviewPlan(); err != nil {
+		t.Fatalf("Failed to view longview plan: %s", err)
+	}
+}
+
+const longviewFixture = `[{
+	"ACTION": "getLatestValue",
+	"NOTIFICATIONS": [],
+	"DATA": {
+		"CPU": {"cpu0": {"user": [{"x": 1500000000, "y": 12.5}], "system": [{"x": 1500000000, "y": 2.25}], "nice": [], "wait": [{"x": 1500000000, "y": 0.5}]}},
+		"Memory": {"real": {"used": [{"x": 1500000000, "y": 512000}], "free": [{"x": 1500000000, "y": 256000}]}, "swap": {"used": [{"x": 1500000000, "y": 0}]}},
+		"Disk": {"/dev/sda": {"reads": [{"x": 1500000000, "y": 3}], "writes": [{"x": 1500000000, "y": 7}], "fs": {"path": "/", "free": [{"x": 1500000000, "y": 1024}]}}},
+		"Network": {"Interface": {"eth0": {"rx_bytes": [{"x": 1500000000, "y": 4096}], "tx_bytes": [{"x": 1500000000, "y": 2048}]}}, "mac_addr": "f2:3c:91:00:00:00"},
+		"Processes": {"nginx": {"longname": "nginx: worker process", "www-data": {"cpu": [{"x": 1500000000, "y": 1.5}], "count": [{"x": 1500000000, "y": 4}]}}},
+		"Load": [{"x": 1500000000, "y": 0.42}],
+		"Uptime": 86400
+	}
+}]`
+
+func Test_FetchLongviewData(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Failed to parse form: %s", err)
+		}
+
+		if r.Form.Get("api_key") != "longview-key" {
+			fmt.Fprint(w, `[{"ACTION": "getLatestValue", "DATA": [], "NOTIFICATIONS": [{"CODE": 4, "SEVERITY": 3, "TEXT": "Authentication failed"}]}]`)
+			return
+		}
+
+		if r.Form.Get("api_action") != "getLatestValue" {
+			t.Errorf("Expected getLatestValue action, but got %s", r.Form.Get("api_action"))
+		}
+
+		fmt.Fprint(w, longviewFixture)
+	}))
+	defer server.Close()
+
+	client := lingo.NewLongviewClientWithFetchURI(lingo.NewAPIClient("", nil), server.URL)
+
+	data, err := client.FetchLongviewData(lingo.LongviewDataRequest{APIKey: "longview-key"})
+	if err != nil {
+		t.Fatalf("Failed to fetch longview data: %s", err)
+	}
+
+	if data.CPU["cpu0"].User[0].Value != 12.5 {
+		t.Fatalf("Expected cpu0 user 12.5, but got %+v", data.CPU["cpu0"].User)
+	}
+
+	if !data.CPU["cpu0"].User[0].Time.Equal(time.Unix(1500000000, 0)) {
+		t.Fatalf("Sample time wasn't decoded: %s", data.CPU["cpu0"].User[0].Time)
+	}
+
+	if data.Memory.Real.Used[0].Value != 512000 {
+		t.Fatalf("Expected used memory 512000, but got %+v", data.Memory.Real.Used)
+	}
+
+	if data.Disk["/dev/sda"].FS.Path != "/" || data.Disk["/dev/sda"].Writes[0].Value != 7 {
+		t.Fatalf("Disk wasn't decoded: %+v", data.Disk["/dev/sda"])
+	}
+
+	if data.Network.Interface["eth0"].RxBytes[0].Value != 4096 {
+		t.Fatalf("Network wasn't decoded: %+v", data.Network.Interface)
+	}
+
+	nginx := data.Processes["nginx"]
+	if nginx.LongName != "nginx: worker process" || nginx.Users["www-data"].Count[0].Value != 4 {
+		t.Fatalf("Processes weren't decoded: %+v", nginx)
+	}
+
+	if data.Uptime != 86400 || data.Load[0].Value != 0.42 {
+		t.Fatalf("Load and uptime weren't decoded: %v %v", data.Load, data.Uptime)
+	}
+
+	if _, err := client.FetchLongviewData(lingo.LongviewDataRequest{APIKey: "wrong"}); err == nil {
+		t.Fatal("Expected Longview notification to be surfaced as an error")
+	}
+}
+
+func Test_FetchLongviewDataRange(t *testing.T) {
+	var form url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Failed to parse form: %s", err)
+		}
+
+		form = r.Form
+		fmt.Fprint(w, longviewFixture)
+	}))
+	defer server.Close()
+
+	client := lingo.NewLongviewClientWithFetchURI(lingo.NewAPIClient("", nil), server.URL)
+	start := time.Now().Add(-time.Hour)
+
+	before := time.Now().Unix()
+	if _, err := client.FetchLongviewData(lingo.LongviewDataRequest{APIKey: "longview-key", Start: start}); err != nil {
+		t.Fatalf("Failed to fetch longview data: %s", err)
+	}
+
+	if form.Get("api_action") != "getValues" || form.Get("start") != strconv.FormatInt(start.Unix(), 10) {
+		t.Fatalf("Expected getValues from %d, but got %v", start.Unix(), form)
+	}
+
+	end, err := strconv.ParseInt(form.Get("end"), 10, 64)
+	if err != nil || end < before || end > time.Now().Unix() {
+		t.Fatalf("Expected end to default to now, but got %s", form.Get("end"))
+	}
+
+	form = nil
+	if _, err := client.FetchLongviewData(lingo.LongviewDataRequest{APIKey: "longview-key", Start: start, End: start.Add(-time.Minute)}); err == nil {
+		t.Fatal("Expected an error when end is before start")
+	}
+
+	if form != nil {
+		t.Fatal("Expected no request when end is before start")
+	}
+}
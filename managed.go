@@ -0,0 +1,224 @@
+package lingo
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// A ManagedServiceType is an enumeration of the kinds of checks Linode Managed can perform.
+type ManagedServiceType string
+
+// Enum values for ManagedServiceType.
+const (
+	ManagedServiceURL = ManagedServiceType("url")
+	ManagedServiceTCP = ManagedServiceType("tcp")
+)
+
+// A ManagedServiceStatus is an enumeration of possible monitored service statuses.
+type ManagedServiceStatus string
+
+// Enum values for ManagedServiceStatus.
+const (
+	ManagedServiceStatusDisabled = ManagedServiceStatus("disabled")
+	ManagedServiceStatusPending  = ManagedServiceStatus("pending")
+	ManagedServiceStatusOK       = ManagedServiceStatus("ok")
+	ManagedServiceStatusProblem  = ManagedServiceStatus("problem")
+)
+
+// A ManagedService represents a service monitored by Linode Managed.
+type ManagedService struct {
+	ID                uint                 `json:"id"`
+	Label             string               `json:"label"`
+	ServiceType       ManagedServiceType   `json:"service_type"`
+	Address           string               `json:"address"`
+	Timeout           uint                 `json:"timeout"`
+	Body              string               `json:"body"`
+	ConsultationGroup string               `json:"consultation_group"`
+	Notes             string               `json:"notes"`
+	Region            string               `json:"region"`
+	Credentials       []uint               `json:"credentials"`
+	Status            ManagedServiceStatus `json:"status"`
+	Created           Time                 `json:"created"`
+	Updated           Time                 `json:"updated"`
+}
+
+// A ManagedServiceRequest is a parameter struct for creating or updating a monitored service. The
+// Body is a string that must appear in the response for URL checks to pass. The ID is only used
+// when updating.
+type ManagedServiceRequest struct {
+	ID                uint               `json:"-"`
+	Label             string             `json:"label"`
+	ServiceType       ManagedServiceType `json:"service_type"`
+	Address           string             `json:"address"`
+	Timeout           uint               `json:"timeout"`
+	Body              string             `json:"body,omitempty"`
+	ConsultationGroup string             `json:"consultation_group,omitempty"`
+	Notes             string             `json:"notes,omitempty"`
+	Region            string             `json:"region,omitempty"`
+	Credentials       []uint             `json:"credentials,omitempty"`
+}
+
+// ManagedPhone holds the phone numbers for a Managed contact.
+type ManagedPhone struct {
+	Primary   string `json:"primary,omitempty"`
+	Secondary string `json:"secondary,omitempty"`
+}
+
+// A ManagedContact represents a person Linode Managed will reach out to when a service fails.
+type ManagedContact struct {
+	ID      uint         `json:"id"`
+	Name    string       `json:"name"`
+	Email   string       `json:"email"`
+	Phone   ManagedPhone `json:"phone"`
+	Group   string       `json:"group"`
+	Updated Time         `json:"updated"`
+}
+
+// A ManagedContactRequest is a parameter struct for creating or updating a Managed contact. The ID
+// is only used when updating.
+type ManagedContactRequest struct {
+	ID    uint         `json:"-"`
+	Name  string       `json:"name"`
+	Email string       `json:"email"`
+	Phone ManagedPhone `json:"phone"`
+	Group string       `json:"group,omitempty"`
+}
+
+// A ManagedCredential represents a credential stored for Linode Managed to use when responding to
+// an issue. The secret itself can never be read back.
+type ManagedCredential struct {
+	ID            uint   `json:"id"`
+	Label         string `json:"label"`
+	LastDecrypted *Time  `json:"last_decrypted"`
+}
+
+// A CreateManagedCredentialRequest is a parameter struct for storing a new Managed credential.
+type CreateManagedCredentialRequest struct {
+	Label    string `json:"label"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password"`
+}
+
+// An UpdateManagedCredentialRequest is a parameter struct for relabelling a Managed credential.
+type UpdateManagedCredentialRequest struct {
+	ID    uint   `json:"-"`
+	Label string `json:"label"`
+}
+
+// An UpdateManagedCredentialLoginRequest is a parameter struct for replacing the username and
+// password stored in a Managed credential.
+type UpdateManagedCredentialLoginRequest struct {
+	ID       uint   `json:"-"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password"`
+}
+
+// ManagedSSHSettings describe how Linode Managed should access a Linode over SSH.
+type ManagedSSHSettings struct {
+	Access bool   `json:"access"`
+	User   string `json:"user,omitempty"`
+	IP     string `json:"ip,omitempty"`
+	Port   uint   `json:"port,omitempty"`
+}
+
+// ManagedLinodeSettings holds the Linode Managed settings for a single Linode.
+type ManagedLinodeSettings struct {
+	ID    uint               `json:"id"`
+	Label string             `json:"label"`
+	Group string             `json:"group"`
+	SSH   ManagedSSHSettings `json:"ssh"`
+}
+
+// A ManagedIssue represents a problem detected by Linode Managed. The Entity is the support ticket
+// opened for the issue.
+type ManagedIssue struct {
+	ID       uint         `json:"id"`
+	Services []uint       `json:"services"`
+	Entity   TicketEntity `json:"entity"`
+	Created  Time         `json:"created"`
+}
+
+// A ManagedStatSample is a single data point in the Linode Managed stats.
+type ManagedStatSample struct {
+	Time  time.Time
+	Value float64
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for ManagedStatSamples, which are
+// encoded as {"x": <unix milliseconds>, "y": <value>}.
+func (s *ManagedStatSample) UnmarshalJSON(data []byte) error {
+	var point struct {
+		X float64 `json:"x"`
+		Y float64 `json:"y"`
+	}
+
+	if err := json.Unmarshal(data, &point); err != nil {
+		return err
+	}
+
+	s.Time = time.Unix(0, int64(point.X)*int64(time.Millisecond)).UTC()
+	s.Value = point.Y
+	return nil
+}
+
+// ManagedStats holds the usage stats aggregated across every Linode on a Linode Managed account.
+type ManagedStats struct {
+	CPU    []ManagedStatSample `json:"cpu"`
+	Disk   []ManagedStatSample `json:"disk"`
+	NetIn  []ManagedStatSample `json:"net_in"`
+	NetOut []ManagedStatSample `json:"net_out"`
+	Swap   []ManagedStatSample `json:"swap"`
+}
+
+// A Manager works with Linode Managed services, contacts, credentials, settings and issues.
+type Manager interface {
+	ListManagedServices() ([]ManagedService, error)
+	ViewManagedService(id uint) (ManagedService, error)
+	CreateManagedService(req ManagedServiceRequest) (ManagedService, error)
+	UpdateManagedService(req ManagedServiceRequest) (ManagedService, error)
+	DeleteManagedService(id uint) error
+	EnableManagedService(id uint) (ManagedService, error)
+	DisableManagedService(id uint) (ManagedService, error)
+
+	ListManagedContacts() ([]ManagedContact, error)
+	ViewManagedContact(id uint) (ManagedContact, error)
+	CreateManagedContact(req ManagedContactRequest) (ManagedContact, error)
+	UpdateManagedContact(req ManagedContactRequest) (ManagedContact, error)
+	DeleteManagedContact(id uint) error
+
+	ListManagedCredentials() ([]ManagedCredential, error)
+	ViewManagedCredential(id uint) (ManagedCredential, error)
+	CreateManagedCredential(req CreateManagedCredentialRequest) (ManagedCredential, error)
+	UpdateManagedCredential(req UpdateManagedCredentialRequest) (ManagedCredential, error)
+	UpdateManagedCredentialLogin(req UpdateManagedCredentialLoginRequest) error
+	RevokeManagedCredential(id uint) error
+	ViewManagedSSHKey() (string, error)
+
+	ListManagedLinodeSettings() ([]ManagedLinodeSettings, error)
+	ViewManagedLinodeSettings(linodeID uint) (ManagedLinodeSettings, error)
+	UpdateManagedLinodeSettings(settings ManagedLinodeSettings) (ManagedLinodeSettings, error)
+
+	ListManagedIssues() ([]ManagedIssue, error)
+	ViewManagedIssue(id uint) (ManagedIssue, error)
+	ViewManagedStats() (ManagedStats, error)
+}
+
+// ValidateManagedServiceType validates whether or not a test string is a ManagedServiceType enum.
+func ValidateManagedServiceType(test string) bool {
+	switch ManagedServiceType(test) {
+	case ManagedServiceURL, ManagedServiceTCP:
+		return true
+	default:
+		return false
+	}
+}
+
+// ValidateManagedServiceStatus validates whether or not a test string is a ManagedServiceStatus enum.
+func ValidateManagedServiceStatus(test string) bool {
+	switch ManagedServiceStatus(test) {
+	case ManagedServiceStatusDisabled, ManagedServiceStatusPending, ManagedServiceStatusOK, ManagedServiceStatusProblem:
+		return true
+	default:
+		return false
+	}
+}
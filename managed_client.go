@@ -0,0 +1,463 @@
+package lingo
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// A ManagedClient implements the Manager interface and provides all of the functionality for
+// working with Linode Managed.
+type ManagedClient struct {
+	api APIClient
+}
+
+// NewManagedClient returns a new ManagedClient given a valid APIClient.
+func NewManagedClient(api APIClient) ManagedClient {
+	return ManagedClient{api: api}
+}
+
+// ListManagedServices retrieves a slice of the services monitored by Linode Managed.
+func (c ManagedClient) ListManagedServices() ([]ManagedService, error) {
+	data, err := c.api.Get("managed/services")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make request for ListManagedServices")
+	}
+
+	var results Results
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, errors.Wrap(err, "failed to decode ListManagedServices response")
+	}
+
+	var services []ManagedService
+	if err := json.Unmarshal(results.Data, &services); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal ListManagedServices data")
+	}
+
+	return services, nil
+}
+
+// ViewManagedService retrieves a specific monitored service.
+func (c ManagedClient) ViewManagedService(id uint) (ManagedService, error) {
+	var service ManagedService
+
+	data, err := c.api.Get(fmt.Sprintf("managed/services/%d", id))
+	if err != nil {
+		return service, errors.Wrap(err, "failed to make request for ViewManagedService")
+	}
+
+	if err := json.Unmarshal(data, &service); err != nil {
+		return service, errors.Wrap(err, "failed to unmarshal ViewManagedService data")
+	}
+
+	return service, nil
+}
+
+// CreateManagedService starts monitoring a new service.
+func (c ManagedClient) CreateManagedService(req ManagedServiceRequest) (ManagedService, error) {
+	var service ManagedService
+
+	payload, err := json.Marshal(&req)
+	if err != nil {
+		return service, errors.Wrap(err, "failed to marshal request for CreateManagedService")
+	}
+
+	data, err := c.api.Post("managed/services", payload)
+	if err != nil {
+		return service, errors.Wrap(err, "failed to make request for CreateManagedService")
+	}
+
+	if err := json.Unmarshal(data, &service); err != nil {
+		return service, errors.Wrap(err, "failed to decode CreateManagedService response")
+	}
+
+	return service, nil
+}
+
+// UpdateManagedService updates a specific monitored service if it exists.
+func (c ManagedClient) UpdateManagedService(req ManagedServiceRequest) (ManagedService, error) {
+	var service ManagedService
+
+	payload, err := json.Marshal(&req)
+	if err != nil {
+		return service, errors.Wrap(err, "failed to marshal request for UpdateManagedService")
+	}
+
+	data, err := c.api.Put(fmt.Sprintf("managed/services/%d", req.ID), payload)
+	if err != nil {
+		return service, errors.Wrap(err, "failed to make request for UpdateManagedService")
+	}
+
+	if err := json.Unmarshal(data, &service); err != nil {
+		return service, errors.Wrap(err, "failed to decode UpdateManagedService response")
+	}
+
+	return service, nil
+}
+
+// DeleteManagedService stops monitoring a specific service and deletes it.
+func (c ManagedClient) DeleteManagedService(id uint) error {
+	if _, err := c.api.Delete(fmt.Sprintf("managed/services/%d", id)); err != nil {
+		return errors.Wrap(err, "failed to make request for DeleteManagedService")
+	}
+
+	return nil
+}
+
+// EnableManagedService resumes monitoring a disabled service.
+func (c ManagedClient) EnableManagedService(id uint) (ManagedService, error) {
+	var service ManagedService
+
+	data, err := c.api.Post(fmt.Sprintf("managed/services/%d/enable", id), nil)
+	if err != nil {
+		return service, errors.Wrap(err, "failed to make request for EnableManagedService")
+	}
+
+	if err := json.Unmarshal(data, &service); err != nil {
+		return service, errors.Wrap(err, "failed to decode EnableManagedService response")
+	}
+
+	return service, nil
+}
+
+// DisableManagedService temporarily stops monitoring a service without deleting it.
+func (c ManagedClient) DisableManagedService(id uint) (ManagedService, error) {
+	var service ManagedService
+
+	data, err := c.api.Post(fmt.Sprintf("managed/services/%d/disable", id), nil)
+	if err != nil {
+		return service, errors.Wrap(err, "failed to make request for DisableManagedService")
+	}
+
+	if err := json.Unmarshal(data, &service); err != nil {
+		return service, errors.Wrap(err, "failed to decode DisableManagedService response")
+	}
+
+	return service, nil
+}
+
+// ListManagedContacts retrieves a slice of the Linode Managed contacts.
+func (c ManagedClient) ListManagedContacts() ([]ManagedContact, error) {
+	data, err := c.api.Get("managed/contacts")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make request for ListManagedContacts")
+	}
+
+	var results Results
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, errors.Wrap(err, "failed to decode ListManagedContacts response")
+	}
+
+	var contacts []ManagedContact
+	if err := json.Unmarshal(results.Data, &contacts); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal ListManagedContacts data")
+	}
+
+	return contacts, nil
+}
+
+// ViewManagedContact retrieves a specific Linode Managed contact.
+func (c ManagedClient) ViewManagedContact(id uint) (ManagedContact, error) {
+	var contact ManagedContact
+
+	data, err := c.api.Get(fmt.Sprintf("managed/contacts/%d", id))
+	if err != nil {
+		return contact, errors.Wrap(err, "failed to make request for ViewManagedContact")
+	}
+
+	if err := json.Unmarshal(data, &contact); err != nil {
+		return contact, errors.Wrap(err, "failed to unmarshal ViewManagedContact data")
+	}
+
+	return contact, nil
+}
+
+// CreateManagedContact adds a new Linode Managed contact.
+func (c ManagedClient) CreateManagedContact(req ManagedContactRequest) (ManagedContact, error) {
+	var contact ManagedContact
+
+	payload, err := json.Marshal(&req)
+	if err != nil {
+		return contact, errors.Wrap(err, "failed to marshal request for CreateManagedContact")
+	}
+
+	data, err := c.api.Post("managed/contacts", payload)
+	if err != nil {
+		return contact, errors.Wrap(err, "failed to make request for CreateManagedContact")
+	}
+
+	if err := json.Unmarshal(data, &contact); err != nil {
+		return contact, errors.Wrap(err, "failed to decode CreateManagedContact response")
+	}
+
+	return contact, nil
+}
+
+// UpdateManagedContact updates a specific Linode Managed contact if it exists.
+func (c ManagedClient) UpdateManagedContact(req ManagedContactRequest) (ManagedContact, error) {
+	var contact ManagedContact
+
+	payload, err := json.Marshal(&req)
+	if err != nil {
+		return contact, errors.Wrap(err, "failed to marshal request for UpdateManagedContact")
+	}
+
+	data, err := c.api.Put(fmt.Sprintf("managed/contacts/%d", req.ID), payload)
+	if err != nil {
+		return contact, errors.Wrap(err, "failed to make request for UpdateManagedContact")
+	}
+
+	if err := json.Unmarshal(data, &contact); err != nil {
+		return contact, errors.Wrap(err, "failed to decode UpdateManagedContact response")
+	}
+
+	return contact, nil
+}
+
+// DeleteManagedContact deletes a specific Linode Managed contact.
+func (c ManagedClient) DeleteManagedContact(id uint) error {
+	if _, err := c.api.Delete(fmt.Sprintf("managed/contacts/%d", id)); err != nil {
+		return errors.Wrap(err, "failed to make request for DeleteManagedContact")
+	}
+
+	return nil
+}
+
+// ListManagedCredentials retrieves a slice of the credentials stored for Linode Managed.
+func (c ManagedClient) ListManagedCredentials() ([]ManagedCredential, error) {
+	data, err := c.api.Get("managed/credentials")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make request for ListManagedCredentials")
+	}
+
+	var results Results
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, errors.Wrap(err, "failed to decode ListManagedCredentials response")
+	}
+
+	var credentials []ManagedCredential
+	if err := json.Unmarshal(results.Data, &credentials); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal ListManagedCredentials data")
+	}
+
+	return credentials, nil
+}
+
+// ViewManagedCredential retrieves a specific stored credential.
+func (c ManagedClient) ViewManagedCredential(id uint) (ManagedCredential, error) {
+	var credential ManagedCredential
+
+	data, err := c.api.Get(fmt.Sprintf("managed/credentials/%d", id))
+	if err != nil {
+		return credential, errors.Wrap(err, "failed to make request for ViewManagedCredential")
+	}
+
+	if err := json.Unmarshal(data, &credential); err != nil {
+		return credential, errors.Wrap(err, "failed to unmarshal ViewManagedCredential data")
+	}
+
+	return credential, nil
+}
+
+// CreateManagedCredential stores a new credential for Linode Managed to use.
+func (c ManagedClient) CreateManagedCredential(req CreateManagedCredentialRequest) (ManagedCredential, error) {
+	var credential ManagedCredential
+
+	payload, err := json.Marshal(&req)
+	if err != nil {
+		return credential, errors.Wrap(err, "failed to marshal request for CreateManagedCredential")
+	}
+
+	data, err := c.api.Post("managed/credentials", payload)
+	if err != nil {
+		return credential, errors.Wrap(err, "failed to make request for CreateManagedCredential")
+	}
+
+	if err := json.Unmarshal(data, &credential); err != nil {
+		return credential, errors.Wrap(err, "failed to decode CreateManagedCredential response")
+	}
+
+	return credential, nil
+}
+
+// UpdateManagedCredential relabels a specific stored credential.
+func (c ManagedClient) UpdateManagedCredential(req UpdateManagedCredentialRequest) (ManagedCredential, error) {
+	var credential ManagedCredential
+
+	payload, err := json.Marshal(&req)
+	if err != nil {
+		return credential, errors.Wrap(err, "failed to marshal request for UpdateManagedCredential")
+	}
+
+	data, err := c.api.Put(fmt.Sprintf("managed/credentials/%d", req.ID), payload)
+	if err != nil {
+		return credential, errors.Wrap(err, "failed to make request for UpdateManagedCredential")
+	}
+
+	if err := json.Unmarshal(data, &credential); err != nil {
+		return credential, errors.Wrap(err, "failed to decode UpdateManagedCredential response")
+	}
+
+	return credential, nil
+}
+
+// UpdateManagedCredentialLogin replaces the username and password stored in a specific credential.
+func (c ManagedClient) UpdateManagedCredentialLogin(req UpdateManagedCredentialLoginRequest) error {
+	payload, err := json.Marshal(&req)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request for UpdateManagedCredentialLogin")
+	}
+
+	if _, err := c.api.Post(fmt.Sprintf("managed/credentials/%d/update", req.ID), payload); err != nil {
+		return errors.Wrap(err, "failed to make request for UpdateManagedCredentialLogin")
+	}
+
+	return nil
+}
+
+// RevokeManagedCredential permanently revokes a specific stored credential.
+func (c ManagedClient) RevokeManagedCredential(id uint) error {
+	if _, err := c.api.Post(fmt.Sprintf("managed/credentials/%d/revoke", id), nil); err != nil {
+		return errors.Wrap(err, "failed to make request for RevokeManagedCredential")
+	}
+
+	return nil
+}
+
+// ViewManagedSSHKey retrieves the public key Linode Managed uses to access Linodes over SSH. It
+// needs to be added to the authorized keys of each Linode with SSH access enabled.
+func (c ManagedClient) ViewManagedSSHKey() (string, error) {
+	data, err := c.api.Get("managed/credentials/sshkey")
+	if err != nil {
+		return "", errors.Wrap(err, "failed to make request for ViewManagedSSHKey")
+	}
+
+	var key struct {
+		SSHKey string `json:"ssh_key"`
+	}
+
+	if err := json.Unmarshal(data, &key); err != nil {
+		return "", errors.Wrap(err, "failed to unmarshal ViewManagedSSHKey data")
+	}
+
+	return key.SSHKey, nil
+}
+
+// ListManagedLinodeSettings retrieves the Linode Managed settings for every Linode on the account.
+func (c ManagedClient) ListManagedLinodeSettings() ([]ManagedLinodeSettings, error) {
+	data, err := c.api.Get("managed/linode-settings")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make request for ListManagedLinodeSettings")
+	}
+
+	var results Results
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, errors.Wrap(err, "failed to decode ListManagedLinodeSettings response")
+	}
+
+	var settings []ManagedLinodeSettings
+	if err := json.Unmarshal(results.Data, &settings); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal ListManagedLinodeSettings data")
+	}
+
+	return settings, nil
+}
+
+// ViewManagedLinodeSettings retrieves the Linode Managed settings for a specific Linode.
+func (c ManagedClient) ViewManagedLinodeSettings(linodeID uint) (ManagedLinodeSettings, error) {
+	var settings ManagedLinodeSettings
+
+	data, err := c.api.Get(fmt.Sprintf("managed/linode-settings/%d", linodeID))
+	if err != nil {
+		return settings, errors.Wrap(err, "failed to make request for ViewManagedLinodeSettings")
+	}
+
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return settings, errors.Wrap(err, "failed to unmarshal ViewManagedLinodeSettings data")
+	}
+
+	return settings, nil
+}
+
+// UpdateManagedLinodeSettings changes how Linode Managed accesses a specific Linode over SSH.
+func (c ManagedClient) UpdateManagedLinodeSettings(settings ManagedLinodeSettings) (ManagedLinodeSettings, error) {
+	var updated ManagedLinodeSettings
+
+	payload, err := json.Marshal(&settings)
+	if err != nil {
+		return updated, errors.Wrap(err, "failed to marshal request for UpdateManagedLinodeSettings")
+	}
+
+	data, err := c.api.Put(fmt.Sprintf("managed/linode-settings/%d", settings.ID), payload)
+	if err != nil {
+		return updated, errors.Wrap(err, "failed to make request for UpdateManagedLinodeSettings")
+	}
+
+	if err := json.Unmarshal(data, &updated); err != nil {
+		return updated, errors.Wrap(err, "failed to decode UpdateManagedLinodeSettings response")
+	}
+
+	return updated, nil
+}
+
+// ListManagedIssues retrieves a slice of the issues Linode Managed has detected.
+func (c ManagedClient) ListManagedIssues() ([]ManagedIssue, error) {
+	data, err := c.api.Get("managed/issues")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make request for ListManagedIssues")
+	}
+
+	var results Results
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, errors.Wrap(err, "failed to decode ListManagedIssues response")
+	}
+
+	var issues []ManagedIssue
+	if err := json.Unmarshal(results.Data, &issues); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal ListManagedIssues data")
+	}
+
+	return issues, nil
+}
+
+// ViewManagedIssue retrieves a specific Linode Managed issue.
+func (c ManagedClient) ViewManagedIssue(id uint) (ManagedIssue, error) {
+	var issue ManagedIssue
+
+	data, err := c.api.Get(fmt.Sprintf("managed/issues/%d", id))
+	if err != nil {
+		return issue, errors.Wrap(err, "failed to make request for ViewManagedIssue")
+	}
+
+	if err := json.Unmarshal(data, &issue); err != nil {
+		return issue, errors.Wrap(err, "failed to unmarshal ViewManagedIssue data")
+	}
+
+	return issue, nil
+}
+
+// ViewManagedStats retrieves the usage stats for every Linode on a Linode Managed account over the
+// past 24 hours.
+func (c ManagedClient) ViewManagedStats() (ManagedStats, error) {
+	var stats ManagedStats
+
+	data, err := c.api.Get("managed/stats")
+	if err != nil {
+		return stats, errors.Wrap(err, "failed to make request for ViewManagedStats")
+	}
+
+	var wrapper struct {
+		Data json.RawMessage `json:"data"`
+	}
+
+	if err := json.Unmarshal(data, &wrapper); err != nil {
+		return stats, errors.Wrap(err, "failed to decode ViewManagedStats response")
+	}
+
+	if err := json.Unmarshal(wrapper.Data, &stats); err != nil {
+		return stats, errors.Wrap(err, "failed to unmarshal ViewManagedStats data")
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,154 @@
+package lingo_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/eriktate/lingo"
+)
+
+func Test_CRUDManagedService(t *testing.T) {
+	apiKey := os.Getenv("LINODE_API_KEY")
+	api := lingo.NewAPIClient(apiKey, nil)
+	client := lingo.NewManagedClient(api)
+
+	service, err := client.CreateManagedService(lingo.ManagedServiceRequest{
+		Label:             "lingo-test",
+		ServiceType:       lingo.ManagedServiceURL,
+		Address:           "https://example.org",
+		Timeout:           30,
+		Body:              "Example Domain",
+		ConsultationGroup: "lingo",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create managed service: %s", err)
+	}
+
+	updateService := lingo.ManagedServiceRequest{
+		ID:          service.ID,
+		Label:       "lingo-test-updated",
+		ServiceType: lingo.ManagedServiceTCP,
+		Address:     "example.org:443",
+		Timeout:     10,
+	}
+
+	if _, err := client.UpdateManagedService(updateService); err != nil {
+		t.Fatalf("Failed to update managed service: %s", err)
+	}
+
+	getService, err := client.ViewManagedService(service.ID)
+	if err != nil {
+		t.Fatalf("Failed to view managed service: %s", err)
+	}
+
+	if getService.Label != updateService.Label || getService.ServiceType != lingo.ManagedServiceTCP {
+		t.Fatal("Update of managed service didn't actually occur")
+	}
+
+	disabled, err := client.DisableManagedService(service.ID)
+	if err != nil {
+		t.Fatalf("Failed to disable managed service: %s", err)
+	}
+
+	if disabled.Status != lingo.ManagedServiceStatusDisabled {
+		t.Fatalf("Expected managed service to be disabled, but it was %s", disabled.Status)
+	}
+
+	if _, err := client.EnableManagedService(service.ID); err != nil {
+		t.Fatalf("Failed to enable managed service: %s", err)
+	}
+
+	if err := client.DeleteManagedService(service.ID); err != nil {
+		t.Fatalf("Failed to delete managed service: %s", err)
+	}
+}
+
+func Test_CRUDManagedContact(t *testing.T) {
+	apiKey := os.Getenv("LINODE_API_KEY")
+	api := lingo.NewAPIClient(apiKey, nil)
+	client := lingo.NewManagedClient(api)
+
+	contact, err := client.CreateManagedContact(lingo.ManagedContactRequest{
+		Name:  "Lingo Test",
+		Email: "lingo-test@example.org",
+		Phone: lingo.ManagedPhone{Primary: "555-555-0100"},
+		Group: "lingo",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create managed contact: %s", err)
+	}
+
+	updateContact := lingo.ManagedContactRequest{
+		ID:    contact.ID,
+		Name:  contact.Name,
+		Email: contact.Email,
+		Phone: lingo.ManagedPhone{Primary: "555-555-0100", Secondary: "555-555-0101"},
+		Group: "lingo",
+	}
+
+	if _, err := client.UpdateManagedContact(updateContact); err != nil {
+		t.Fatalf("Failed to update managed contact: %s", err)
+	}
+
+	getContact, err := client.ViewManagedContact(contact.ID)
+	if err != nil {
+		t.Fatalf("Failed to view managed contact: %s", err)
+	}
+
+	if getContact.Phone.Secondary != updateContact.Phone.Secondary {
+		t.Fatal("Update of managed contact didn't actually occur")
+	}
+
+	if err := client.DeleteManagedContact(contact.ID); err != nil {
+		t.Fatalf("Failed to delete managed contact: %s", err)
+	}
+}
+
+func Test_ManagedCredential(t *testing.T) {
+	apiKey := os.Getenv("LINODE_API_KEY")
+	api := lingo.NewAPIClient(apiKey, nil)
+	client := lingo.NewManagedClient(api)
+
+	credential, err := client.CreateManagedCredential(lingo.CreateManagedCredentialRequest{
+		Label:    "lingo-test",
+		Username: "lingo",
+		Password: "not-a-real-password",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create managed credential: %s", err)
+	}
+
+	if _, err := client.UpdateManagedCredential(lingo.UpdateManagedCredentialRequest{ID: credential.ID, Label: "lingo-test-updated"}); err != nil {
+		t.Fatalf("Failed to update managed credential: %s", err)
+	}
+
+	if err := client.UpdateManagedCredentialLogin(lingo.UpdateManagedCredentialLoginRequest{ID: credential.ID, Password: "still-not-real"}); err != nil {
+		t.Fatalf("Failed to update managed credential login: %s", err)
+	}
+
+	if err := client.RevokeManagedCredential(credential.ID); err != nil {
+		t.Fatalf("Failed to revoke managed credential: %s", err)
+	}
+}
+
+func Test_ViewManaged(t *testing.T) {
+	apiKey := os.Getenv("LINODE_API_KEY")
+	api := lingo.NewAPIClient(apiKey, nil)
+	client := lingo.NewManagedClient(api)
+
+	if _, err := client.ViewManagedSSHKey(); err != nil {
+		t.Fatalf("Failed to view managed SSH key: %s", err)
+	}
+
+	if _, err := client.ListManagedLinodeSettings(); err != nil {
+		t.Fatalf("Failed to list managed linode settings: %s", err)
+	}
+
+	if _, err := client.ListManagedIssues(); err != nil {
+		t.Fatalf("Failed to list managed issues: %s", err)
+	}
+
+	if _, err := client.ViewManagedStats(); err != nil {
+		t.Fatalf("Failed to view managed stats: %s", err)
+	}
+}
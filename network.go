@@ -1,5 +1,13 @@
 package lingo
 
+import (
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
 // An AddressType is an enumeration of possible network address types.
 type AddressType string
 
@@ -55,15 +63,147 @@ type Address struct {
 	Region     string      `json:"region"`
 }
 
-// An IPv6Range represents a range.
+// An IPv6Range represents a range. RouteTarget is the address the range is routed to, which is
+// only reported when viewing a single range.
 type IPv6Range struct {
-	Range  string `json:"range"`
-	Region string `json:"region"`
+	Range       string `json:"range"`
+	Prefix      uint   `json:"prefix"`
+	Region      string `json:"region"`
+	RouteTarget string `json:"route_target"`
+	Linodes     []uint `json:"linodes"`
+}
+
+// CreateIPv6RangeRequest is a parameter struct for creating IPv6 ranges. The range is routed to
+// either a Linode instance's SLAAC address or an explicit RouteTarget, but not both.
+type CreateIPv6RangeRequest struct {
+	LinodeID     uint   `json:"linode_id,omitempty"`
+	RouteTarget  string `json:"route_target,omitempty"`
+	PrefixLength uint   `json:"prefix_length"`
+}
+
+// InstanceIPv4 groups the IPv4 addresses of a Linode instance. Shared addresses are ones other
+// instances have shared with it for failover.
+type InstanceIPv4 struct {
+	Public   []Address `json:"public"`
+	Private  []Address `json:"private"`
+	Shared   []Address `json:"shared"`
+	Reserved []Address `json:"reserved"`
+}
+
+// InstanceIPv6 groups the IPv6 addresses and ranges of a Linode instance.
+type InstanceIPv6 struct {
+	SLAAC     Address     `json:"slaac"`
+	LinkLocal Address     `json:"link_local"`
+	Global    []IPv6Range `json:"global"`
+}
+
+// InstanceIPs represents every address assigned to a Linode instance.
+type InstanceIPs struct {
+	IPv4 InstanceIPv4 `json:"ipv4"`
+	IPv6 InstanceIPv6 `json:"ipv6"`
 }
 
 // An IPv6Pool represents a pool.
 type IPv6Pool IPv6Range
 
+// Addr parses the address.
+func (a Address) Addr() (netip.Addr, error) {
+	addr, err := netip.ParseAddr(a.Address)
+	return addr, errors.Wrapf(err, "invalid address %q", a.Address)
+}
+
+// CIDR parses the address along with the length of the network it's on, falling back on the subnet
+// mask when the prefix length is missing.
+func (a Address) CIDR() (netip.Prefix, error) {
+	addr, err := a.Addr()
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	bits := int(a.Prefix)
+	if bits == 0 && a.SubnetMask != "" {
+		mask, err := netip.ParseAddr(a.SubnetMask)
+		if err != nil {
+			return netip.Prefix{}, errors.Wrapf(err, "invalid subnet mask %q for %s", a.SubnetMask, a.Address)
+		}
+
+		bits = maskBits(mask)
+		if bits < 0 {
+			return netip.Prefix{}, errors.Errorf("invalid subnet mask %q for %s", a.SubnetMask, a.Address)
+		}
+	}
+
+	prefix := netip.PrefixFrom(addr, bits)
+	if !prefix.IsValid() {
+		return prefix, errors.Errorf("invalid prefix length %d for %s", bits, a.Address)
+	}
+
+	return prefix, nil
+}
+
+// GatewayAddr parses the address of the gateway. The result is invalid, without an error, when the
+// address doesn't have a gateway.
+func (a Address) GatewayAddr() (netip.Addr, error) {
+	if a.Gateway == "" {
+		return netip.Addr{}, nil
+	}
+
+	addr, err := netip.ParseAddr(a.Gateway)
+	return addr, errors.Wrapf(err, "invalid gateway %q for %s", a.Gateway, a.Address)
+}
+
+// CIDR parses the range. The API reports some ranges in CIDR notation and others as a bare address
+// with the prefix length alongside it, and both are handled.
+func (r IPv6Range) CIDR() (netip.Prefix, error) {
+	cidr := r.Range
+	if !strings.Contains(cidr, "/") {
+		if r.Prefix == 0 {
+			return netip.Prefix{}, errors.Errorf("range %q has no prefix length", r.Range)
+		}
+
+		cidr = r.Range + "/" + strconv.FormatUint(uint64(r.Prefix), 10)
+	}
+
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return prefix, errors.Wrapf(err, "invalid range %q", cidr)
+	}
+
+	return prefix.Masked(), nil
+}
+
+// CIDR parses the pool, the same way IPv6Range.CIDR does.
+func (p IPv6Pool) CIDR() (netip.Prefix, error) {
+	return IPv6Range(p).CIDR()
+}
+
+// maskBits returns the length of a subnet mask such as 255.255.255.0, or -1 if the mask isn't
+// contiguous.
+func maskBits(mask netip.Addr) int {
+	bits := 0
+	b := mask.AsSlice()
+	for i, octet := range b {
+		for bit := 7; bit >= 0; bit-- {
+			if octet&(1<<uint(bit)) == 0 {
+				for _, rest := range b[i+1:] {
+					if rest != 0 {
+						return -1
+					}
+				}
+
+				if octet&(1<<uint(bit)-1) != 0 {
+					return -1
+				}
+
+				return bits
+			}
+			bits++
+		}
+	}
+
+	return bits
+}
+
 // A Networker works with Linode network configurations.
 type Networker interface {
 	ListAddresses() ([]Address, error)
@@ -72,8 +212,15 @@ type Networker interface {
 	AssignAddress(req AssignAddressRequest) error
 	UpdateAddressRDNS(req UpdateRDNSRequest) (Address, error)
 	ConfigureSharing(req SharingRequest) error
-	ListIPv6Pools() ([]IPv6Range, error)
-	ListIPv6Ranges() ([]IPv6Pool, error)
+	ListIPv6Pools() ([]IPv6Pool, error)
+	ListIPv6Ranges() ([]IPv6Range, error)
+	ViewIPv6Range(ipRange string) (IPv6Range, error)
+	CreateIPv6Range(req CreateIPv6RangeRequest) (IPv6Range, error)
+	DeleteIPv6Range(ipRange string) error
+	ListInstanceIPs(linodeID uint) (InstanceIPs, error)
+	ViewInstanceIP(linodeID uint, address string) (Address, error)
+	DeleteInstanceIP(linodeID uint, address string) error
+	ViewSharing(linodeID uint) ([]Address, error)
 }
 
 // ValidateAddressType validates whether or not a test string is an AddressType enum.
@@ -3,8 +3,8 @@ package lingo
 import (
 	"encoding/json"
 	"fmt"
-	"log"
 	"net/url"
+	"strings"
 
 	"github.com/pkg/errors"
 )
@@ -44,7 +44,6 @@ func (c NetworkClient) ListAddresses() ([]Address, error) {
 func (c NetworkClient) ViewAddress(address string) (Address, error) {
 	var ip Address
 	url := fmt.Sprintf("networking/ips/%s", url.PathEscape(address))
-	log.Printf("hitting: %s", url)
 	data, err := c.api.Get(url)
 	if err != nil {
 		return ip, errors.Wrap(err, "failed to make request for ViewAddress")
@@ -166,3 +165,113 @@ func (c NetworkClient) ListIPv6Ranges() ([]IPv6Range, error) {
 
 	return ranges, nil
 }
+
+// ViewIPv6Range retrieves a single IPv6 range, including where it's routed. The range can be given
+// with or without its prefix length.
+func (c NetworkClient) ViewIPv6Range(ipRange string) (IPv6Range, error) {
+	var r IPv6Range
+	data, err := c.api.Get(fmt.Sprintf("networking/ipv6/ranges/%s", url.PathEscape(rangeAddress(ipRange))))
+	if err != nil {
+		return r, errors.Wrap(err, "failed to make request for ViewIPv6Range")
+	}
+
+	if err := json.Unmarshal(data, &r); err != nil {
+		return r, errors.Wrap(err, "failed to decode ViewIPv6Range response")
+	}
+
+	return r, nil
+}
+
+// CreateIPv6Range creates a new IPv6 range routed to a Linode instance or route target.
+func (c NetworkClient) CreateIPv6Range(req CreateIPv6RangeRequest) (IPv6Range, error) {
+	var r IPv6Range
+	if (req.LinodeID == 0) == (req.RouteTarget == "") {
+		return r, errors.New("exactly one of LinodeID or RouteTarget must be set for CreateIPv6Range")
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return r, errors.Wrap(err, "failed to marshal request for CreateIPv6Range")
+	}
+
+	data, err := c.api.Post("networking/ipv6/ranges", payload)
+	if err != nil {
+		return r, errors.Wrap(err, "failed to make request for CreateIPv6Range")
+	}
+
+	if err := json.Unmarshal(data, &r); err != nil {
+		return r, errors.Wrap(err, "failed to decode CreateIPv6Range response")
+	}
+
+	return r, nil
+}
+
+// DeleteIPv6Range deletes an IPv6 range. The range can be given with or without its prefix length.
+func (c NetworkClient) DeleteIPv6Range(ipRange string) error {
+	if _, err := c.api.Delete(fmt.Sprintf("networking/ipv6/ranges/%s", url.PathEscape(rangeAddress(ipRange)))); err != nil {
+		return errors.Wrap(err, "failed to make request for DeleteIPv6Range")
+	}
+
+	return nil
+}
+
+// ListInstanceIPs retrieves every address assigned to a Linode instance.
+func (c NetworkClient) ListInstanceIPs(linodeID uint) (InstanceIPs, error) {
+	var ips InstanceIPs
+	data, err := c.api.Get(fmt.Sprintf("linode/instances/%d/ips", linodeID))
+	if err != nil {
+		return ips, errors.Wrap(err, "failed to make request for ListInstanceIPs")
+	}
+
+	if err := json.Unmarshal(data, &ips); err != nil {
+		return ips, errors.Wrap(err, "failed to decode ListInstanceIPs response")
+	}
+
+	return ips, nil
+}
+
+// ViewInstanceIP retrieves a single address assigned to a Linode instance.
+func (c NetworkClient) ViewInstanceIP(linodeID uint, address string) (Address, error) {
+	var ip Address
+	data, err := c.api.Get(fmt.Sprintf("linode/instances/%d/ips/%s", linodeID, url.PathEscape(address)))
+	if err != nil {
+		return ip, errors.Wrap(err, "failed to make request for ViewInstanceIP")
+	}
+
+	if err := json.Unmarshal(data, &ip); err != nil {
+		return ip, errors.Wrap(err, "failed to decode ViewInstanceIP response")
+	}
+
+	return ip, nil
+}
+
+// DeleteInstanceIP removes an IPv4 address from a Linode instance. Every instance must keep at
+// least one public IPv4 address.
+func (c NetworkClient) DeleteInstanceIP(linodeID uint, address string) error {
+	if _, err := c.api.Delete(fmt.Sprintf("linode/instances/%d/ips/%s", linodeID, url.PathEscape(address))); err != nil {
+		return errors.Wrap(err, "failed to make request for DeleteInstanceIP")
+	}
+
+	return nil
+}
+
+// ViewSharing retrieves the addresses currently shared with a Linode instance. Passing the result
+// back to ConfigureSharing with an address added or removed changes what's shared, since sharing
+// replaces the whole set each time.
+func (c NetworkClient) ViewSharing(linodeID uint) ([]Address, error) {
+	ips, err := c.ListInstanceIPs(linodeID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list addresses for ViewSharing")
+	}
+
+	return ips.IPv4.Shared, nil
+}
+
+// rangeAddress strips the prefix length from a range, which the range endpoints don't accept.
+func rangeAddress(ipRange string) string {
+	if i := strings.Index(ipRange, "/"); i >= 0 {
+		return ipRange[:i]
+	}
+
+	return ipRange
+}
@@ -19,11 +19,11 @@ func Test_CRUDNetwork(t *testing.T) {
 	}
 
 	createLinode := lingo.CreateLinodeRequest{
-		Region:   "us-east-1a",
-		Type:     "g5-standard-2",
-		Image:    "linode/debian9",
-		RootPass: "test123",
-		Booted:   true,
+		Region:           "us-east-1a",
+		Type:             "g5-standard-2",
+		Image:            "linode/debian9",
+		GenerateRootPass: true,
+		Booted:           true,
 	}
 
 	testLinode, err := linodeClient.CreateLinode(createLinode)
@@ -37,10 +37,55 @@ func Test_CRUDNetwork(t *testing.T) {
 		Public:   false,
 	}
 
-	if _, err := client.AllocateAddress(allocateRequest); err != nil {
+	private, err := client.AllocateAddress(allocateRequest)
+	if err != nil {
 		t.Fatalf("Failed to allocate address: %s", err)
 	}
 
+	ips, err := client.ListInstanceIPs(testLinode.ID)
+	if err != nil {
+		t.Fatalf("Failed to list instance addresses: %s", err)
+	}
+
+	if len(ips.IPv4.Private) != 1 || ips.IPv4.Private[0].Address != private.Address {
+		t.Fatalf("Expected private address %s, but got %+v", private.Address, ips.IPv4.Private)
+	}
+
+	if ips.IPv6.SLAAC.Address == "" || ips.IPv6.LinkLocal.Address == "" {
+		t.Fatalf("Expected SLAAC and link-local IPv6 addresses, but got %+v", ips.IPv6)
+	}
+
+	if _, err := client.ViewInstanceIP(testLinode.ID, private.Address); err != nil {
+		t.Fatalf("Failed to view instance address: %s", err)
+	}
+
+	shared, err := client.ViewSharing(testLinode.ID)
+	if err != nil {
+		t.Fatalf("Failed to view sharing: %s", err)
+	}
+
+	if len(shared) != 0 {
+		t.Fatalf("Expected a new Linode to have no shared addresses, but got %+v", shared)
+	}
+
+	ipRange, err := client.CreateIPv6Range(lingo.CreateIPv6RangeRequest{LinodeID: testLinode.ID, PrefixLength: 64})
+	if err != nil {
+		t.Fatalf("Failed to create IPv6 range: %s", err)
+	}
+
+	viewRange, err := client.ViewIPv6Range(ipRange.Range)
+	if err != nil {
+		t.Fatalf("Failed to view IPv6 range: %s", err)
+	}
+
+	if viewRange.RouteTarget != ipRange.RouteTarget {
+		t.Fatalf("Expected range to be routed to %s, but got %s", ipRange.RouteTarget, viewRange.RouteTarget)
+	}
+
+	if err := client.DeleteIPv6Range(ipRange.Range); err != nil {
+		t.Fatalf("Failed to delete IPv6 range: %s", err)
+	}
+
 	// TODO: Figure out a way to test this.
 	// rdnsRequest := lingo.UpdateRDNSRequest{
 	// 	Address: testLinode.IPv4[0],
@@ -68,4 +113,8 @@ func Test_CRUDNetwork(t *testing.T) {
 	if len(addrs) != expected {
 		t.Fatalf("Something strange happened. Expected to list %d addresses, but got %d", expected, len(addrs))
 	}
+
+	if err := client.DeleteInstanceIP(testLinode.ID, private.Address); err != nil {
+		t.Fatalf("Failed to delete instance address: %s", err)
+	}
 }
@@ -0,0 +1,83 @@
+package lingo
+
+import (
+	"fmt"
+	"io"
+)
+
+// An Object represents a single object stored in a Linode Object Storage bucket.
+type Object struct {
+	Key          string `xml:"Key"`
+	Size         int64  `xml:"Size"`
+	ETag         string `xml:"ETag"`
+	LastModified string `xml:"LastModified"`
+	StorageClass string `xml:"StorageClass"`
+}
+
+// A MultipartUpload identifies an in-progress multipart upload. Holding on to the UploadID is
+// what allows an interrupted upload to be resumed.
+type MultipartUpload struct {
+	Bucket   string `xml:"Bucket"`
+	Key      string `xml:"Key"`
+	UploadID string `xml:"UploadId"`
+}
+
+// An ObjectPart represents a single part of a multipart upload that has been stored.
+type ObjectPart struct {
+	PartNumber int    `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+	Size       int64  `xml:"Size"`
+}
+
+// A PutObjectRequest is a parameter struct for uploading a single object in one request.
+type PutObjectRequest struct {
+	Bucket      string
+	Key         string
+	Body        []byte
+	ContentType string
+	ACL         ObjectACL
+}
+
+// An UploadRequest is a parameter struct for uploading a large object in parts. Setting UploadID
+// resumes an existing multipart upload, skipping any parts that have already been stored.
+type UploadRequest struct {
+	Bucket      string
+	Key         string
+	Body        io.ReaderAt
+	Size        int64
+	ContentType string
+	UploadID    string
+
+	// PartSize defaults to 8MiB and can't be smaller than 5MiB.
+	PartSize int64
+	// Concurrency is the number of parts to upload at once. Defaults to 4.
+	Concurrency int
+}
+
+// An ObjectError is the structured error type that the S3 API returns on 4xx and 5xx status codes.
+type ObjectError struct {
+	StatusCode int    `xml:"-"`
+	Code       string `xml:"Code"`
+	Message    string `xml:"Message"`
+	Resource   string `xml:"Resource"`
+}
+
+// Error implements the go error interface for ObjectErrors.
+func (e ObjectError) Error() string {
+	return fmt.Sprintf("Object Storage Error: %d %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// An Objecter moves objects in and out of Linode Object Storage buckets.
+type Objecter interface {
+	PutObject(req PutObjectRequest) error
+	GetObject(bucket, key string) (io.ReadCloser, error)
+	ListObjects(bucket, prefix string) ([]Object, error)
+	DeleteObject(bucket, key string) error
+
+	CreateMultipartUpload(bucket, key, contentType string) (MultipartUpload, error)
+	UploadPart(upload MultipartUpload, number int, data []byte) (ObjectPart, error)
+	ListParts(upload MultipartUpload) ([]ObjectPart, error)
+	CompleteMultipartUpload(upload MultipartUpload, parts []ObjectPart) error
+	AbortMultipartUpload(upload MultipartUpload) error
+	Upload(req UploadRequest) (MultipartUpload, error)
+}
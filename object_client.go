@@ -0,0 +1,434 @@
+package lingo
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultPartSize    = 8 << 20
+	minPartSize        = 5 << 20
+	defaultConcurrency = 4
+)
+
+// An ObjectClient implements the Objecter interface and talks to the S3 compatible API of a Linode
+// Object Storage cluster, signing every request with an access key issued by ObjectStorageClient.
+type ObjectClient struct {
+	endpoint  string
+	region    string
+	accessKey string
+	secretKey string
+	h         *http.Client
+}
+
+// NewObjectClient returns a new ObjectClient for the given cluster (e.g. us-east-1) that
+// authenticates with the given access key.
+func NewObjectClient(cluster string, key ObjectStorageKey) ObjectClient {
+	return NewObjectClientWithEndpoint(fmt.Sprintf("https://%s.linodeobjects.com", cluster), cluster, key)
+}
+
+// NewObjectClientWithEndpoint returns a new ObjectClient that talks to an arbitrary S3 compatible
+// endpoint. This is mostly useful for pointing at a local stand-in.
+func NewObjectClientWithEndpoint(endpoint, region string, key ObjectStorageKey) ObjectClient {
+	return ObjectClient{
+		endpoint:  strings.TrimSuffix(endpoint, "/"),
+		region:    region,
+		accessKey: key.AccessKey,
+		secretKey: key.SecretKey,
+		h:         http.DefaultClient,
+	}
+}
+
+// PutObject uploads a single object in one request.
+func (c ObjectClient) PutObject(req PutObjectRequest) error {
+	headers := make(http.Header)
+	if req.ContentType != "" {
+		headers.Set("Content-Type", req.ContentType)
+	}
+
+	if req.ACL != "" {
+		headers.Set("X-Amz-Acl", string(req.ACL))
+	}
+
+	res, err := c.do(http.MethodPut, req.Bucket, req.Key, nil, headers, req.Body)
+	if err != nil {
+		return errors.Wrap(err, "failed to make request for PutObject")
+	}
+	res.Body.Close()
+
+	return nil
+}
+
+// GetObject retrieves a single object. The caller is responsible for closing the returned reader.
+func (c ObjectClient) GetObject(bucket, key string) (io.ReadCloser, error) {
+	res, err := c.do(http.MethodGet, bucket, key, nil, nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make request for GetObject")
+	}
+
+	return res.Body, nil
+}
+
+// ListObjects retrieves a slice of every object in a bucket whose key starts with the given prefix.
+func (c ObjectClient) ListObjects(bucket, prefix string) ([]Object, error) {
+	var objects []Object
+	var token string
+
+	for {
+		query := url.Values{"list-type": {"2"}}
+		if prefix != "" {
+			query.Set("prefix", prefix)
+		}
+
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+
+		var results struct {
+			Contents              []Object `xml:"Contents"`
+			IsTruncated           bool     `xml:"IsTruncated"`
+			NextContinuationToken string   `xml:"NextContinuationToken"`
+		}
+
+		if err := c.doXML(http.MethodGet, bucket, "", query, nil, nil, &results); err != nil {
+			return nil, errors.Wrap(err, "failed to make request for ListObjects")
+		}
+
+		objects = append(objects, results.Contents...)
+		if !results.IsTruncated || results.NextContinuationToken == "" {
+			return objects, nil
+		}
+
+		token = results.NextContinuationToken
+	}
+}
+
+// DeleteObject deletes a single object from a bucket.
+func (c ObjectClient) DeleteObject(bucket, key string) error {
+	res, err := c.do(http.MethodDelete, bucket, key, nil, nil, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to make request for DeleteObject")
+	}
+	res.Body.Close()
+
+	return nil
+}
+
+// CreateMultipartUpload starts a new multipart upload for the given object.
+func (c ObjectClient) CreateMultipartUpload(bucket, key, contentType string) (MultipartUpload, error) {
+	var upload MultipartUpload
+
+	headers := make(http.Header)
+	if contentType != "" {
+		headers.Set("Content-Type", contentType)
+	}
+
+	if err := c.doXML(http.MethodPost, bucket, key, url.Values{"uploads": {""}}, headers, nil, &upload); err != nil {
+		return upload, errors.Wrap(err, "failed to make request for CreateMultipartUpload")
+	}
+
+	return upload, nil
+}
+
+// UploadPart stores a single numbered part of a multipart upload. Part numbers start at 1.
+func (c ObjectClient) UploadPart(upload MultipartUpload, number int, data []byte) (ObjectPart, error) {
+	part := ObjectPart{PartNumber: number, Size: int64(len(data))}
+
+	query := url.Values{
+		"partNumber": {strconv.Itoa(number)},
+		"uploadId":   {upload.UploadID},
+	}
+
+	res, err := c.do(http.MethodPut, upload.Bucket, upload.Key, query, nil, data)
+	if err != nil {
+		return part, errors.Wrapf(err, "failed to make request for UploadPart %d", number)
+	}
+	res.Body.Close()
+
+	part.ETag = res.Header.Get("ETag")
+	return part, nil
+}
+
+// ListParts retrieves a slice of the parts that have already been stored for a multipart upload.
+func (c ObjectClient) ListParts(upload MultipartUpload) ([]ObjectPart, error) {
+	var parts []ObjectPart
+	var marker string
+
+	for {
+		query := url.Values{"uploadId": {upload.UploadID}}
+		if marker != "" {
+			query.Set("part-number-marker", marker)
+		}
+
+		var results struct {
+			Parts                []ObjectPart `xml:"Part"`
+			IsTruncated          bool         `xml:"IsTruncated"`
+			NextPartNumberMarker string       `xml:"NextPartNumberMarker"`
+		}
+
+		if err := c.doXML(http.MethodGet, upload.Bucket, upload.Key, query, nil, nil, &results); err != nil {
+			return nil, errors.Wrap(err, "failed to make request for ListParts")
+		}
+
+		parts = append(parts, results.Parts...)
+		if !results.IsTruncated || results.NextPartNumberMarker == "" {
+			return parts, nil
+		}
+
+		marker = results.NextPartNumberMarker
+	}
+}
+
+// CompleteMultipartUpload assembles the given parts into the final object.
+func (c ObjectClient) CompleteMultipartUpload(upload MultipartUpload, parts []ObjectPart) error {
+	sorted := make([]ObjectPart, len(parts))
+	copy(sorted, parts)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].PartNumber < sorted[j].PartNumber })
+
+	type completePart struct {
+		PartNumber int    `xml:"PartNumber"`
+		ETag       string `xml:"ETag"`
+	}
+
+	complete := struct {
+		XMLName xml.Name       `xml:"CompleteMultipartUpload"`
+		Parts   []completePart `xml:"Part"`
+	}{}
+
+	for _, part := range sorted {
+		complete.Parts = append(complete.Parts, completePart{part.PartNumber, part.ETag})
+	}
+
+	payload, err := xml.Marshal(&complete)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request for CompleteMultipartUpload")
+	}
+
+	res, err := c.do(http.MethodPost, upload.Bucket, upload.Key, url.Values{"uploadId": {upload.UploadID}}, nil, payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to make request for CompleteMultipartUpload")
+	}
+	defer res.Body.Close()
+
+	// S3 can report a failure to assemble the object with a 200 status and an error body.
+	data, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return errors.Wrap(err, "failed to read CompleteMultipartUpload response")
+	}
+
+	if bytes.Contains(data, []byte("<Error>")) {
+		objErr := ObjectError{StatusCode: res.StatusCode}
+		if err := xml.Unmarshal(data, &objErr); err != nil {
+			return errors.Wrap(err, "failed to decode CompleteMultipartUpload error")
+		}
+
+		return objErr
+	}
+
+	return nil
+}
+
+// AbortMultipartUpload cancels a multipart upload and discards any parts already stored.
+func (c ObjectClient) AbortMultipartUpload(upload MultipartUpload) error {
+	res, err := c.do(http.MethodDelete, upload.Bucket, upload.Key, url.Values{"uploadId": {upload.UploadID}}, nil, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to make request for AbortMultipartUpload")
+	}
+	res.Body.Close()
+
+	return nil
+}
+
+// Upload stores a large object using a multipart upload, sending up to Concurrency parts at once.
+// If UploadID is set the existing upload is resumed and any parts whose size and checksum already
+// match are skipped. The returned MultipartUpload is populated even when an error occurs so that
+// the caller can hold on to the UploadID and resume later.
+func (c ObjectClient) Upload(req UploadRequest) (MultipartUpload, error) {
+	upload := MultipartUpload{Bucket: req.Bucket, Key: req.Key, UploadID: req.UploadID}
+
+	partSize := req.PartSize
+	if partSize == 0 {
+		partSize = defaultPartSize
+	}
+
+	if partSize < minPartSize {
+		return upload, errors.Errorf("part size must be at least %d bytes", minPartSize)
+	}
+
+	concurrency := req.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultConcurrency
+	}
+
+	existing := make(map[int]ObjectPart)
+	if upload.UploadID == "" {
+		created, err := c.CreateMultipartUpload(req.Bucket, req.Key, req.ContentType)
+		if err != nil {
+			return upload, err
+		}
+
+		upload = created
+	} else {
+		parts, err := c.ListParts(upload)
+		if err != nil {
+			return upload, err
+		}
+
+		for _, part := range parts {
+			existing[part.PartNumber] = part
+		}
+	}
+
+	count := int((req.Size + partSize - 1) / partSize)
+	if count == 0 {
+		count = 1
+	}
+
+	parts := make([]ObjectPart, count)
+	numbers := make(chan int)
+	errs := make(chan error, count)
+
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for number := range numbers {
+				part, err := c.uploadPartFrom(upload, req, number, partSize, existing[number])
+				if err != nil {
+					errs <- err
+					continue
+				}
+
+				parts[number-1] = part
+			}
+		}()
+	}
+
+	for number := 1; number <= count; number++ {
+		numbers <- number
+	}
+	close(numbers)
+	wg.Wait()
+	close(errs)
+
+	if err := <-errs; err != nil {
+		return upload, errors.Wrap(err, "failed to upload parts")
+	}
+
+	if err := c.CompleteMultipartUpload(upload, parts); err != nil {
+		return upload, err
+	}
+
+	return upload, nil
+}
+
+// uploadPartFrom reads a single part out of the request body and uploads it, unless the existing
+// part already matches what would be sent.
+func (c ObjectClient) uploadPartFrom(upload MultipartUpload, req UploadRequest, number int, partSize int64, existing ObjectPart) (ObjectPart, error) {
+	offset := int64(number-1) * partSize
+	size := partSize
+	if offset+size > req.Size {
+		size = req.Size - offset
+	}
+
+	data := make([]byte, size)
+	if _, err := req.Body.ReadAt(data, offset); err != nil && err != io.EOF {
+		return ObjectPart{}, errors.Wrapf(err, "failed to read part %d", number)
+	}
+
+	sum := md5.Sum(data)
+	etag := `"` + hex.EncodeToString(sum[:]) + `"`
+	if existing.PartNumber == number && existing.Size == size && existing.ETag == etag {
+		return existing, nil
+	}
+
+	return c.UploadPart(upload, number, data)
+}
+
+// do makes a signed request to the object storage endpoint and returns the response if it was
+// successful.
+func (c ObjectClient) do(method, bucket, key string, query url.Values, headers http.Header, body []byte) (*http.Response, error) {
+	u, err := url.Parse(c.endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	u.Path = "/" + bucket
+	if key != "" {
+		u.Path += "/" + key
+	}
+
+	if query != nil {
+		u.RawQuery = query.Encode()
+	}
+
+	req, err := http.NewRequest(method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	for name, values := range headers {
+		req.Header[name] = values
+	}
+
+	payloadHash := emptyPayloadHash
+	if len(body) > 0 {
+		payloadHash = hashHex(body)
+	}
+
+	signV4(req, c.accessKey, c.secretKey, c.region, payloadHash, time.Now())
+
+	res, err := c.h.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode >= http.StatusMultipleChoices {
+		defer res.Body.Close()
+
+		objErr := ObjectError{StatusCode: res.StatusCode}
+		data, err := ioutil.ReadAll(res.Body)
+		if err != nil || len(data) == 0 {
+			objErr.Code = http.StatusText(res.StatusCode)
+			return nil, objErr
+		}
+
+		if err := xml.Unmarshal(data, &objErr); err != nil {
+			return nil, errors.Errorf("request failed, could not unmarshal error response. Raw error: %s", string(data))
+		}
+
+		return nil, objErr
+	}
+
+	return res, nil
+}
+
+// doXML makes a signed request and decodes the XML response into v.
+func (c ObjectClient) doXML(method, bucket, key string, query url.Values, headers http.Header, body []byte, v interface{}) error {
+	res, err := c.do(method, bucket, key, query, headers, body)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if err := xml.NewDecoder(res.Body).Decode(v); err != nil {
+		return errors.Wrap(err, "failed to decode response")
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package lingo
+
+// An ObjectStorageCluster represents a Linode Object Storage cluster.
+type ObjectStorageCluster struct {
+	ID               string `json:"id"`
+	Domain           string `json:"domain"`
+	Status           string `json:"status"`
+	Region           string `json:"region"`
+	StaticSiteDomain string `json:"static_site_domain"`
+}
+
+// An ObjectACL is an enumeration of possible access control levels for buckets and objects.
+type ObjectACL string
+
+// Enum values for ObjectACL.
+const (
+	ObjectACLPrivate           = ObjectACL("private")
+	ObjectACLPublicRead        = ObjectACL("public-read")
+	ObjectACLAuthenticatedRead = ObjectACL("authenticated-read")
+	ObjectACLPublicReadWrite   = ObjectACL("public-read-write")
+	ObjectACLCustom            = ObjectACL("custom")
+)
+
+// An ObjectStorageBucket represents a bucket in a Linode Object Storage cluster.
+type ObjectStorageBucket struct {
+	Label    string `json:"label"`
+	Cluster  string `json:"cluster"`
+	Hostname string `json:"hostname"`
+	Objects  uint   `json:"objects"`
+	Size     uint   `json:"size"`
+	Created  Time   `json:"created"`
+}
+
+// A CreateBucketRequest is a parameter struct for specifying a new bucket.
+type CreateBucketRequest struct {
+	Label       string    `json:"label"`
+	Cluster     string    `json:"cluster"`
+	ACL         ObjectACL `json:"acl,omitempty"`
+	CORSEnabled bool      `json:"cors_enabled"`
+}
+
+// A BucketAccessRequest is a parameter struct for updating the ACL and CORS settings of an
+// existing bucket.
+type BucketAccessRequest struct {
+	Cluster     string    `json:"-"`
+	Label       string    `json:"-"`
+	ACL         ObjectACL `json:"acl,omitempty"`
+	CORSEnabled bool      `json:"cors_enabled"`
+}
+
+// An ObjectACLConfig describes the ACL applied to a single object within a bucket.
+type ObjectACLConfig struct {
+	ACL    ObjectACL `json:"acl"`
+	ACLXML string    `json:"acl_xml,omitempty"`
+}
+
+// An UpdateObjectACLRequest is a parameter struct for changing the ACL of a single object.
+type UpdateObjectACLRequest struct {
+	Cluster string    `json:"-"`
+	Bucket  string    `json:"-"`
+	Name    string    `json:"name"`
+	ACL     ObjectACL `json:"acl"`
+}
+
+// A BucketPermission is an enumeration of the permissions a limited access key can have on a bucket.
+type BucketPermission string
+
+// Enum values for BucketPermission.
+const (
+	BucketPermissionReadOnly  = BucketPermission("read_only")
+	BucketPermissionReadWrite = BucketPermission("read_write")
+)
+
+// A BucketAccess grants a limited access key permissions on a single bucket.
+type BucketAccess struct {
+	Cluster     string           `json:"cluster"`
+	BucketName  string           `json:"bucket_name"`
+	Permissions BucketPermission `json:"permissions"`
+}
+
+// An ObjectStorageKey represents an access key pair for Linode Object Storage. The SecretKey is
+// only ever populated in the response to CreateObjectStorageKey.
+type ObjectStorageKey struct {
+	ID           uint           `json:"id"`
+	Label        string         `json:"label"`
+	AccessKey    string         `json:"access_key"`
+	SecretKey    string         `json:"secret_key"`
+	Limited      bool           `json:"limited"`
+	BucketAccess []BucketAccess `json:"bucket_access,omitempty"`
+}
+
+// A CreateObjectStorageKeyRequest is a parameter struct for creating a new access key. Leaving
+// BucketAccess empty creates a key with full access to every bucket on the account.
+type CreateObjectStorageKeyRequest struct {
+	Label        string         `json:"label"`
+	BucketAccess []BucketAccess `json:"bucket_access,omitempty"`
+}
+
+// An UpdateObjectStorageKeyRequest is a parameter struct for updating an existing access key.
+type UpdateObjectStorageKeyRequest struct {
+	ID    uint   `json:"-"`
+	Label string `json:"label"`
+}
+
+// An ObjectURLRequest is a parameter struct for requesting a presigned URL to a single object.
+type ObjectURLRequest struct {
+	Cluster     string `json:"-"`
+	Bucket      string `json:"-"`
+	Method      string `json:"method"`
+	Name        string `json:"name"`
+	ContentType string `json:"content_type,omitempty"`
+	ExpiresIn   uint   `json:"expires_in,omitempty"`
+}
+
+// An ObjectURL is a presigned URL that can be used to access an object without credentials.
+type ObjectURL struct {
+	URL    string `json:"url"`
+	Exists bool   `json:"exists"`
+}
+
+// An ObjectStorager works with Linode Object Storage clusters, buckets and access keys.
+type ObjectStorager interface {
+	ListObjectStorageClusters() ([]ObjectStorageCluster, error)
+	ViewObjectStorageCluster(id string) (ObjectStorageCluster, error)
+
+	ListBuckets() ([]ObjectStorageBucket, error)
+	ListClusterBuckets(cluster string) ([]ObjectStorageBucket, error)
+	ViewBucket(cluster, label string) (ObjectStorageBucket, error)
+	CreateBucket(req CreateBucketRequest) (ObjectStorageBucket, error)
+	DeleteBucket(cluster, label string) error
+	UpdateBucketAccess(req BucketAccessRequest) error
+	ViewObjectACL(cluster, bucket, name string) (ObjectACLConfig, error)
+	UpdateObjectACL(req UpdateObjectACLRequest) (ObjectACLConfig, error)
+	CreateObjectURL(req ObjectURLRequest) (ObjectURL, error)
+
+	ListObjectStorageKeys() ([]ObjectStorageKey, error)
+	ViewObjectStorageKey(id uint) (ObjectStorageKey, error)
+	CreateObjectStorageKey(req CreateObjectStorageKeyRequest) (ObjectStorageKey, error)
+	UpdateObjectStorageKey(req UpdateObjectStorageKeyRequest) (ObjectStorageKey, error)
+	RevokeObjectStorageKey(id uint) error
+}
+
+// ValidateObjectACL validates whether or not a test string is an ObjectACL enum.
+func ValidateObjectACL(test string) bool {
+	switch ObjectACL(test) {
+	case ObjectACLPrivate, ObjectACLPublicRead, ObjectACLAuthenticatedRead, ObjectACLPublicReadWrite, ObjectACLCustom:
+		return true
+	default:
+		return false
+	}
+}
+
+// ValidateBucketPermission validates whether or not a test string is a BucketPermission enum.
+func ValidateBucketPermission(test string) bool {
+	switch BucketPermission(test) {
+	case BucketPermissionReadOnly, BucketPermissionReadWrite:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,306 @@
+package lingo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+// An ObjectStorageClient implements the ObjectStorager interface and provides all of the
+// functionality for managing Object Storage buckets and access keys in a Linode account.
+type ObjectStorageClient struct {
+	api APIClient
+}
+
+// NewObjectStorageClient returns a new ObjectStorageClient given a valid APIClient.
+func NewObjectStorageClient(api APIClient) ObjectStorageClient {
+	return ObjectStorageClient{api: api}
+}
+
+// ListObjectStorageClusters retrieves a slice of the Object Storage clusters available in Linode.
+func (c ObjectStorageClient) ListObjectStorageClusters() ([]ObjectStorageCluster, error) {
+	data, err := c.api.Get("object-storage/clusters")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make request for ListObjectStorageClusters")
+	}
+
+	var results Results
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, errors.Wrap(err, "failed to decode ListObjectStorageClusters response")
+	}
+
+	var clusters []ObjectStorageCluster
+	if err := json.Unmarshal(results.Data, &clusters); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal ListObjectStorageClusters data")
+	}
+
+	return clusters, nil
+}
+
+// ViewObjectStorageCluster retrieves a specific Object Storage cluster.
+func (c ObjectStorageClient) ViewObjectStorageCluster(id string) (ObjectStorageCluster, error) {
+	var cluster ObjectStorageCluster
+
+	data, err := c.api.Get("object-storage/clusters/" + id)
+	if err != nil {
+		return cluster, errors.Wrap(err, "failed to make request for ViewObjectStorageCluster")
+	}
+
+	if err := json.Unmarshal(data, &cluster); err != nil {
+		return cluster, errors.Wrap(err, "failed to unmarshal ViewObjectStorageCluster data")
+	}
+
+	return cluster, nil
+}
+
+// ListBuckets retrieves a slice of every bucket in a Linode account, across all clusters.
+func (c ObjectStorageClient) ListBuckets() ([]ObjectStorageBucket, error) {
+	data, err := c.api.Get("object-storage/buckets")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make request for ListBuckets")
+	}
+
+	var results Results
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, errors.Wrap(err, "failed to decode ListBuckets response")
+	}
+
+	var buckets []ObjectStorageBucket
+	if err := json.Unmarshal(results.Data, &buckets); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal ListBuckets data")
+	}
+
+	return buckets, nil
+}
+
+// ListClusterBuckets retrieves a slice of the buckets in a Linode account that live in the given
+// cluster.
+func (c ObjectStorageClient) ListClusterBuckets(cluster string) ([]ObjectStorageBucket, error) {
+	data, err := c.api.Get("object-storage/buckets/" + cluster)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make request for ListClusterBuckets")
+	}
+
+	var results Results
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, errors.Wrap(err, "failed to decode ListClusterBuckets response")
+	}
+
+	var buckets []ObjectStorageBucket
+	if err := json.Unmarshal(results.Data, &buckets); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal ListClusterBuckets data")
+	}
+
+	return buckets, nil
+}
+
+// ViewBucket retrieves a specific bucket.
+func (c ObjectStorageClient) ViewBucket(cluster, label string) (ObjectStorageBucket, error) {
+	var bucket ObjectStorageBucket
+
+	data, err := c.api.Get(fmt.Sprintf("object-storage/buckets/%s/%s", cluster, label))
+	if err != nil {
+		return bucket, errors.Wrap(err, "failed to make request for ViewBucket")
+	}
+
+	if err := json.Unmarshal(data, &bucket); err != nil {
+		return bucket, errors.Wrap(err, "failed to unmarshal ViewBucket data")
+	}
+
+	return bucket, nil
+}
+
+// CreateBucket creates a new bucket in the requested cluster.
+func (c ObjectStorageClient) CreateBucket(req CreateBucketRequest) (ObjectStorageBucket, error) {
+	var bucket ObjectStorageBucket
+
+	payload, err := json.Marshal(&req)
+	if err != nil {
+		return bucket, errors.Wrap(err, "failed to marshal request for CreateBucket")
+	}
+
+	data, err := c.api.Post("object-storage/buckets", payload)
+	if err != nil {
+		return bucket, errors.Wrap(err, "failed to make request for CreateBucket")
+	}
+
+	if err := json.Unmarshal(data, &bucket); err != nil {
+		return bucket, errors.Wrap(err, "failed to decode CreateBucket response")
+	}
+
+	return bucket, nil
+}
+
+// DeleteBucket deletes a specific bucket. Linode will refuse to delete a bucket that still
+// contains objects.
+func (c ObjectStorageClient) DeleteBucket(cluster, label string) error {
+	if _, err := c.api.Delete(fmt.Sprintf("object-storage/buckets/%s/%s", cluster, label)); err != nil {
+		return errors.Wrap(err, "failed to make request for DeleteBucket")
+	}
+
+	return nil
+}
+
+// UpdateBucketAccess updates the ACL and CORS settings of an existing bucket.
+func (c ObjectStorageClient) UpdateBucketAccess(req BucketAccessRequest) error {
+	payload, err := json.Marshal(&req)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request for UpdateBucketAccess")
+	}
+
+	if _, err := c.api.Post(fmt.Sprintf("object-storage/buckets/%s/%s/access", req.Cluster, req.Label), payload); err != nil {
+		return errors.Wrap(err, "failed to make request for UpdateBucketAccess")
+	}
+
+	return nil
+}
+
+// ViewObjectACL retrieves the ACL applied to a single object within a bucket.
+func (c ObjectStorageClient) ViewObjectACL(cluster, bucket, name string) (ObjectACLConfig, error) {
+	var acl ObjectACLConfig
+
+	path := fmt.Sprintf("object-storage/buckets/%s/%s/object-acl?name=%s", cluster, bucket, url.QueryEscape(name))
+	data, err := c.api.Get(path)
+	if err != nil {
+		return acl, errors.Wrap(err, "failed to make request for ViewObjectACL")
+	}
+
+	if err := json.Unmarshal(data, &acl); err != nil {
+		return acl, errors.Wrap(err, "failed to unmarshal ViewObjectACL data")
+	}
+
+	return acl, nil
+}
+
+// UpdateObjectACL changes the ACL applied to a single object within a bucket.
+func (c ObjectStorageClient) UpdateObjectACL(req UpdateObjectACLRequest) (ObjectACLConfig, error) {
+	var acl ObjectACLConfig
+
+	payload, err := json.Marshal(&req)
+	if err != nil {
+		return acl, errors.Wrap(err, "failed to marshal request for UpdateObjectACL")
+	}
+
+	data, err := c.api.Put(fmt.Sprintf("object-storage/buckets/%s/%s/object-acl", req.Cluster, req.Bucket), payload)
+	if err != nil {
+		return acl, errors.Wrap(err, "failed to make request for UpdateObjectACL")
+	}
+
+	if err := json.Unmarshal(data, &acl); err != nil {
+		return acl, errors.Wrap(err, "failed to decode UpdateObjectACL response")
+	}
+
+	return acl, nil
+}
+
+// CreateObjectURL requests a presigned URL that can be used to perform the requested method on a
+// single object without needing access keys.
+func (c ObjectStorageClient) CreateObjectURL(req ObjectURLRequest) (ObjectURL, error) {
+	var objectURL ObjectURL
+
+	payload, err := json.Marshal(&req)
+	if err != nil {
+		return objectURL, errors.Wrap(err, "failed to marshal request for CreateObjectURL")
+	}
+
+	data, err := c.api.Post(fmt.Sprintf("object-storage/buckets/%s/%s/object-url", req.Cluster, req.Bucket), payload)
+	if err != nil {
+		return objectURL, errors.Wrap(err, "failed to make request for CreateObjectURL")
+	}
+
+	if err := json.Unmarshal(data, &objectURL); err != nil {
+		return objectURL, errors.Wrap(err, "failed to decode CreateObjectURL response")
+	}
+
+	return objectURL, nil
+}
+
+// ListObjectStorageKeys retrieves a slice of the Object Storage access keys in a Linode account.
+func (c ObjectStorageClient) ListObjectStorageKeys() ([]ObjectStorageKey, error) {
+	data, err := c.api.Get("object-storage/keys")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make request for ListObjectStorageKeys")
+	}
+
+	var results Results
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, errors.Wrap(err, "failed to decode ListObjectStorageKeys response")
+	}
+
+	var keys []ObjectStorageKey
+	if err := json.Unmarshal(results.Data, &keys); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal ListObjectStorageKeys data")
+	}
+
+	return keys, nil
+}
+
+// ViewObjectStorageKey retrieves a specific Object Storage access key.
+func (c ObjectStorageClient) ViewObjectStorageKey(id uint) (ObjectStorageKey, error) {
+	var key ObjectStorageKey
+
+	data, err := c.api.Get(fmt.Sprintf("object-storage/keys/%d", id))
+	if err != nil {
+		return key, errors.Wrap(err, "failed to make request for ViewObjectStorageKey")
+	}
+
+	if err := json.Unmarshal(data, &key); err != nil {
+		return key, errors.Wrap(err, "failed to unmarshal ViewObjectStorageKey data")
+	}
+
+	return key, nil
+}
+
+// CreateObjectStorageKey creates a new Object Storage access key. The returned key is the only
+// time the secret key will be made available.
+func (c ObjectStorageClient) CreateObjectStorageKey(req CreateObjectStorageKeyRequest) (ObjectStorageKey, error) {
+	var key ObjectStorageKey
+
+	payload, err := json.Marshal(&req)
+	if err != nil {
+		return key, errors.Wrap(err, "failed to marshal request for CreateObjectStorageKey")
+	}
+
+	data, err := c.api.Post("object-storage/keys", payload)
+	if err != nil {
+		return key, errors.Wrap(err, "failed to make request for CreateObjectStorageKey")
+	}
+
+	if err := json.Unmarshal(data, &key); err != nil {
+		return key, errors.Wrap(err, "failed to decode CreateObjectStorageKey response")
+	}
+
+	return key, nil
+}
+
+// UpdateObjectStorageKey updates the label of an existing Object Storage access key.
+func (c ObjectStorageClient) UpdateObjectStorageKey(req UpdateObjectStorageKeyRequest) (ObjectStorageKey, error) {
+	var key ObjectStorageKey
+
+	payload, err := json.Marshal(&req)
+	if err != nil {
+		return key, errors.Wrap(err, "failed to marshal request for UpdateObjectStorageKey")
+	}
+
+	data, err := c.api.Put(fmt.Sprintf("object-storage/keys/%d", req.ID), payload)
+	if err != nil {
+		return key, errors.Wrap(err, "failed to make request for UpdateObjectStorageKey")
+	}
+
+	if err := json.Unmarshal(data, &key); err != nil {
+		return key, errors.Wrap(err, "failed to decode UpdateObjectStorageKey response")
+	}
+
+	return key, nil
+}
+
+// RevokeObjectStorageKey revokes a specific Object Storage access key.
+func (c ObjectStorageClient) RevokeObjectStorageKey(id uint) error {
+	if _, err := c.api.Delete(fmt.Sprintf("object-storage/keys/%d", id)); err != nil {
+		return errors.Wrap(err, "failed to make request for RevokeObjectStorageKey")
+	}
+
+	return nil
+}
@@ -0,0 +1,178 @@
+package lingo_test
+
+import (
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/eriktate/lingo"
+)
+
+func Test_ObjectStorageClusters(t *testing.T) {
+	apiKey := os.Getenv("LINODE_API_KEY")
+	api := lingo.NewAPIClient(apiKey, nil)
+	client := lingo.NewObjectStorageClient(api)
+
+	clusters, err := client.ListObjectStorageClusters()
+	if err != nil {
+		t.Fatalf("Failed to list clusters: %s", err)
+	}
+
+	if len(clusters) == 0 {
+		t.Fatal("Failed to retrieve any clusters")
+	}
+
+	if _, err := client.ViewObjectStorageCluster(clusters[0].ID); err != nil {
+		t.Fatalf("Failed to view cluster: %s", err)
+	}
+}
+
+func Test_CRUDBucket(t *testing.T) {
+	apiKey := os.Getenv("LINODE_API_KEY")
+	api := lingo.NewAPIClient(apiKey, nil)
+	client := lingo.NewObjectStorageClient(api)
+
+	existing, err := client.ListBuckets()
+	if err != nil {
+		t.Fatalf("Failed to list buckets: %s", err)
+	}
+
+	createBucket := lingo.CreateBucketRequest{
+		Label:   "lingo-test-bucket",
+		Cluster: "us-east-1",
+		ACL:     lingo.ObjectACLPrivate,
+	}
+
+	bucket, err := client.CreateBucket(createBucket)
+	if err != nil {
+		t.Fatalf("Failed to create bucket: %s", err)
+	}
+
+	accessRequest := lingo.BucketAccessRequest{
+		Cluster:     bucket.Cluster,
+		Label:       bucket.Label,
+		ACL:         lingo.ObjectACLPublicRead,
+		CORSEnabled: true,
+	}
+
+	if err := client.UpdateBucketAccess(accessRequest); err != nil {
+		t.Fatalf("Failed to update bucket access: %s", err)
+	}
+
+	buckets, err := client.ListClusterBuckets(bucket.Cluster)
+	if err != nil {
+		t.Fatalf("Failed to list cluster buckets: %s", err)
+	}
+
+	if len(buckets) == 0 {
+		t.Fatal("Failed to retrieve any buckets in cluster")
+	}
+
+	allBuckets, err := client.ListBuckets()
+	if err != nil {
+		t.Fatalf("Failed to list buckets: %s", err)
+	}
+
+	expected := len(existing) + 1
+	if len(allBuckets) != expected {
+		t.Fatalf("Something strange happened. Expected to list %d buckets, but got %d", expected, len(allBuckets))
+	}
+
+	urlRequest := lingo.ObjectURLRequest{
+		Cluster:   bucket.Cluster,
+		Bucket:    bucket.Label,
+		Method:    http.MethodPut,
+		Name:      "artefact.tar.gz",
+		ExpiresIn: 300,
+	}
+
+	objectURL, err := client.CreateObjectURL(urlRequest)
+	if err != nil {
+		t.Fatalf("Failed to create object URL: %s", err)
+	}
+
+	if objectURL.URL == "" {
+		t.Fatal("Expected a presigned URL to be returned")
+	}
+
+	if err := client.DeleteBucket(bucket.Cluster, bucket.Label); err != nil {
+		t.Fatalf("Failed to delete bucket: %s", err)
+	}
+}
+
+func Test_CRUDObjectStorageKey(t *testing.T) {
+	apiKey := os.Getenv("LINODE_API_KEY")
+	api := lingo.NewAPIClient(apiKey, nil)
+	client := lingo.NewObjectStorageClient(api)
+
+	bucket, err := client.CreateBucket(lingo.CreateBucketRequest{
+		Label:   "lingo-test-key-bucket",
+		Cluster: "us-east-1",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create bucket: %s", err)
+	}
+
+	fullKey, err := client.CreateObjectStorageKey(lingo.CreateObjectStorageKeyRequest{Label: "lingo-test-full"})
+	if err != nil {
+		t.Fatalf("Failed to create full access key: %s", err)
+	}
+
+	if fullKey.SecretKey == "" {
+		t.Fatal("Expected secret key to be returned on create")
+	}
+
+	limitedRequest := lingo.CreateObjectStorageKeyRequest{
+		Label: "lingo-test-limited",
+		BucketAccess: []lingo.BucketAccess{
+			{
+				Cluster:     bucket.Cluster,
+				BucketName:  bucket.Label,
+				Permissions: lingo.BucketPermissionReadOnly,
+			},
+		},
+	}
+
+	limitedKey, err := client.CreateObjectStorageKey(limitedRequest)
+	if err != nil {
+		t.Fatalf("Failed to create limited access key: %s", err)
+	}
+
+	if !limitedKey.Limited {
+		t.Fatal("Expected key with bucket access to be limited")
+	}
+
+	updateRequest := lingo.UpdateObjectStorageKeyRequest{
+		ID:    fullKey.ID,
+		Label: "UPDATED",
+	}
+
+	if _, err := client.UpdateObjectStorageKey(updateRequest); err != nil {
+		t.Fatalf("Failed to update key: %s", err)
+	}
+
+	getKey, err := client.ViewObjectStorageKey(fullKey.ID)
+	if err != nil {
+		t.Fatalf("Failed to view key: %s", err)
+	}
+
+	if getKey.Label != updateRequest.Label {
+		t.Fatal("Update of key didn't actually occur")
+	}
+
+	if _, err := client.ListObjectStorageKeys(); err != nil {
+		t.Fatalf("Failed to list keys: %s", err)
+	}
+
+	if err := client.RevokeObjectStorageKey(fullKey.ID); err != nil {
+		t.Fatalf("Failed to revoke full access key: %s", err)
+	}
+
+	if err := client.RevokeObjectStorageKey(limitedKey.ID); err != nil {
+		t.Fatalf("Failed to revoke limited access key: %s", err)
+	}
+
+	if err := client.DeleteBucket(bucket.Cluster, bucket.Label); err != nil {
+		t.Fatalf("Failed to cleanup bucket: %s", err)
+	}
+}
@@ -0,0 +1,480 @@
+package lingo_test
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/eriktate/lingo"
+	"github.com/pkg/errors"
+)
+
+// s3StandIn is a tiny in-memory S3 compatible server that verifies SigV4 signatures. It only
+// implements enough of the API to exercise the ObjectClient.
+type s3StandIn struct {
+	key    lingo.ObjectStorageKey
+	region string
+
+	mu       sync.Mutex
+	objects  map[string][]byte
+	uploads  map[string]map[int][]byte
+	partPuts int
+	uploadID int
+}
+
+func newS3StandIn(key lingo.ObjectStorageKey, region string) *s3StandIn {
+	return &s3StandIn{
+		key:     key,
+		region:  region,
+		objects: make(map[string][]byte),
+		uploads: make(map[string]map[int][]byte),
+	}
+}
+
+func (s *s3StandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+	if err := s.verify(r, body); err != nil {
+		s.fail(w, http.StatusForbidden, "SignatureDoesNotMatch", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/")
+	bucket, key := path, ""
+	if i := strings.Index(path, "/"); i >= 0 {
+		bucket, key = path[:i], path[i+1:]
+	}
+
+	query := r.URL.Query()
+	uploadID := query.Get("uploadId")
+	name := bucket + "/" + key
+
+	switch {
+	case r.Method == http.MethodPost && query.Get("uploads") == "" && hasKey(query, "uploads"):
+		s.uploadID++
+		id := strconv.Itoa(s.uploadID)
+		s.uploads[id] = make(map[int][]byte)
+		writeXML(w, struct {
+			XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+			Bucket   string   `xml:"Bucket"`
+			Key      string   `xml:"Key"`
+			UploadID string   `xml:"UploadId"`
+		}{Bucket: bucket, Key: key, UploadID: id})
+	case r.Method == http.MethodPut && uploadID != "":
+		parts, ok := s.uploads[uploadID]
+		if !ok {
+			s.fail(w, http.StatusNotFound, "NoSuchUpload", "upload does not exist")
+			return
+		}
+
+		number, _ := strconv.Atoi(query.Get("partNumber"))
+		parts[number] = body
+		s.partPuts++
+		w.Header().Set("ETag", etag(body))
+	case r.Method == http.MethodGet && uploadID != "":
+		type part struct {
+			PartNumber int    `xml:"PartNumber"`
+			ETag       string `xml:"ETag"`
+			Size       int    `xml:"Size"`
+		}
+
+		result := struct {
+			XMLName xml.Name `xml:"ListPartsResult"`
+			Parts   []part   `xml:"Part"`
+		}{}
+
+		for number, data := range s.uploads[uploadID] {
+			result.Parts = append(result.Parts, part{number, etag(data), len(data)})
+		}
+
+		sort.Slice(result.Parts, func(i, j int) bool { return result.Parts[i].PartNumber < result.Parts[j].PartNumber })
+		writeXML(w, result)
+	case r.Method == http.MethodPost && uploadID != "":
+		var complete struct {
+			Parts []struct {
+				PartNumber int    `xml:"PartNumber"`
+				ETag       string `xml:"ETag"`
+			} `xml:"Part"`
+		}
+
+		if err := xml.Unmarshal(body, &complete); err != nil {
+			s.fail(w, http.StatusBadRequest, "MalformedXML", err.Error())
+			return
+		}
+
+		var object []byte
+		for _, part := range complete.Parts {
+			data, ok := s.uploads[uploadID][part.PartNumber]
+			if !ok || etag(data) != part.ETag {
+				s.fail(w, http.StatusBadRequest, "InvalidPart", fmt.Sprintf("part %d is invalid", part.PartNumber))
+				return
+			}
+
+			object = append(object, data...)
+		}
+
+		s.objects[name] = object
+		delete(s.uploads, uploadID)
+		writeXML(w, struct {
+			XMLName xml.Name `xml:"CompleteMultipartUploadResult"`
+			Key     string   `xml:"Key"`
+		}{Key: key})
+	case r.Method == http.MethodDelete && uploadID != "":
+		delete(s.uploads, uploadID)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodGet && key == "":
+		s.list(w, bucket, query)
+	case r.Method == http.MethodPut:
+		s.objects[name] = body
+		w.Header().Set("ETag", etag(body))
+	case r.Method == http.MethodGet:
+		data, ok := s.objects[name]
+		if !ok {
+			s.fail(w, http.StatusNotFound, "NoSuchKey", "The specified key does not exist.")
+			return
+		}
+
+		w.Write(data)
+	case r.Method == http.MethodDelete:
+		delete(s.objects, name)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		s.fail(w, http.StatusNotImplemented, "NotImplemented", r.Method+" "+r.URL.String())
+	}
+}
+
+// list pages through keys two at a time so that continuation tokens get exercised.
+func (s *s3StandIn) list(w http.ResponseWriter, bucket string, query url.Values) {
+	type content struct {
+		Key  string `xml:"Key"`
+		Size int    `xml:"Size"`
+		ETag string `xml:"ETag"`
+	}
+
+	result := struct {
+		XMLName               xml.Name  `xml:"ListBucketResult"`
+		Contents              []content `xml:"Contents"`
+		IsTruncated           bool      `xml:"IsTruncated"`
+		NextContinuationToken string    `xml:"NextContinuationToken,omitempty"`
+	}{}
+
+	var keys []string
+	for name := range s.objects {
+		key := strings.TrimPrefix(name, bucket+"/")
+		if key != name && strings.HasPrefix(key, query.Get("prefix")) && key > query.Get("continuation-token") {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	if len(keys) > 2 {
+		keys = keys[:2]
+		result.IsTruncated = true
+		result.NextContinuationToken = keys[1]
+	}
+
+	for _, key := range keys {
+		data := s.objects[bucket+"/"+key]
+		result.Contents = append(result.Contents, content{key, len(data), etag(data)})
+	}
+
+	writeXML(w, result)
+}
+
+// verify checks the SigV4 signature of a request against the stand-in's access key.
+func (s *s3StandIn) verify(r *http.Request, body []byte) error {
+	auth := strings.TrimPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 ")
+	fields := make(map[string]string)
+	for _, field := range strings.Split(auth, ", ") {
+		parts := strings.SplitN(field, "=", 2)
+		if len(parts) == 2 {
+			fields[parts[0]] = parts[1]
+		}
+	}
+
+	credential := strings.Split(fields["Credential"], "/")
+	if len(credential) != 5 || credential[0] != s.key.AccessKey || credential[2] != s.region {
+		return fmt.Errorf("bad credential scope %q", fields["Credential"])
+	}
+
+	payloadHash := r.Header.Get("X-Amz-Content-Sha256")
+	sum := sha256.Sum256(body)
+	if payloadHash != hex.EncodeToString(sum[:]) {
+		return fmt.Errorf("payload hash mismatch")
+	}
+
+	var segments []string
+	for _, segment := range strings.Split(r.URL.Path, "/") {
+		segments = append(segments, awsEscape(segment))
+	}
+
+	query := r.URL.Query()
+	var queryKeys []string
+	for key := range query {
+		queryKeys = append(queryKeys, key)
+	}
+	sort.Strings(queryKeys)
+
+	var pairs []string
+	for _, key := range queryKeys {
+		pairs = append(pairs, awsEscape(key)+"="+awsEscape(query.Get(key)))
+	}
+
+	var headers string
+	for _, name := range strings.Split(fields["SignedHeaders"], ";") {
+		value := r.Header.Get(name)
+		if name == "host" {
+			value = r.Host
+		}
+
+		headers += name + ":" + value + "\n"
+	}
+
+	canonical := strings.Join([]string{
+		r.Method,
+		strings.Join(segments, "/"),
+		strings.Join(pairs, "&"),
+		headers,
+		fields["SignedHeaders"],
+		payloadHash,
+	}, "\n")
+
+	canonicalSum := sha256.Sum256([]byte(canonical))
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		r.Header.Get("X-Amz-Date"),
+		strings.Join(credential[1:], "/"),
+		hex.EncodeToString(canonicalSum[:]),
+	}, "\n")
+
+	key := []byte("AWS4" + s.key.SecretKey)
+	for _, part := range credential[1:] {
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(part))
+		key = mac.Sum(nil)
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(stringToSign))
+	if hex.EncodeToString(mac.Sum(nil)) != fields["Signature"] {
+		return fmt.Errorf("signature mismatch")
+	}
+
+	return nil
+}
+
+func (s *s3StandIn) fail(w http.ResponseWriter, status int, code, message string) {
+	w.WriteHeader(status)
+	xml.NewEncoder(w).Encode(struct {
+		XMLName xml.Name `xml:"Error"`
+		Code    string   `xml:"Code"`
+		Message string   `xml:"Message"`
+	}{Code: code, Message: message})
+}
+
+func writeXML(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/xml")
+	xml.NewEncoder(w).Encode(v)
+}
+
+func hasKey(query url.Values, key string) bool {
+	_, ok := query[key]
+	return ok
+}
+
+func etag(data []byte) string {
+	sum := md5.Sum(data)
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
+
+func awsEscape(s string) string {
+	escaped := url.QueryEscape(s)
+	escaped = strings.Replace(escaped, "+", "%20", -1)
+	return strings.Replace(escaped, "%7E", "~", -1)
+}
+
+func newTestObjectClient(t *testing.T) (lingo.ObjectClient, *s3StandIn, func()) {
+	key := lingo.ObjectStorageKey{AccessKey: "TESTACCESSKEY", SecretKey: "testsecretkey"}
+	standIn := newS3StandIn(key, "us-east-1")
+	server := httptest.NewServer(standIn)
+
+	return lingo.NewObjectClientWithEndpoint(server.URL, "us-east-1", key), standIn, server.Close
+}
+
+func randomBytes(t *testing.T, size int) []byte {
+	data := make([]byte, size)
+	if _, err := rand.Read(data); err != nil {
+		t.Fatalf("Failed to generate random data: %s", err)
+	}
+
+	return data
+}
+
+func Test_Objects(t *testing.T) {
+	client, _, cleanup := newTestObjectClient(t)
+	defer cleanup()
+
+	keys := []string{"artefacts/a.tar.gz", "artefacts/b c+d.tar.gz", "artefacts/nested/e.tar.gz", "logs/build.log"}
+	for _, key := range keys {
+		req := lingo.PutObjectRequest{
+			Bucket:      "builds",
+			Key:         key,
+			Body:        []byte(key),
+			ContentType: "application/gzip",
+			ACL:         lingo.ObjectACLPrivate,
+		}
+
+		if err := client.PutObject(req); err != nil {
+			t.Fatalf("Failed to put object %s: %s", key, err)
+		}
+	}
+
+	body, err := client.GetObject("builds", keys[1])
+	if err != nil {
+		t.Fatalf("Failed to get object: %s", err)
+	}
+	defer body.Close()
+
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		t.Fatalf("Failed to read object: %s", err)
+	}
+
+	if string(data) != keys[1] {
+		t.Fatalf("Expected object contents %q, but got %q", keys[1], data)
+	}
+
+	objects, err := client.ListObjects("builds", "artefacts/")
+	if err != nil {
+		t.Fatalf("Failed to list objects: %s", err)
+	}
+
+	if len(objects) != 3 {
+		t.Fatalf("Expected to list 3 objects, but got %d", len(objects))
+	}
+
+	if err := client.DeleteObject("builds", keys[0]); err != nil {
+		t.Fatalf("Failed to delete object: %s", err)
+	}
+
+	_, err = client.GetObject("builds", keys[0])
+	objErr, ok := errors.Cause(err).(lingo.ObjectError)
+	if !ok || objErr.Code != "NoSuchKey" {
+		t.Fatalf("Expected NoSuchKey error for deleted object, but got: %v", err)
+	}
+}
+
+func Test_ObjectBadSignature(t *testing.T) {
+	key := lingo.ObjectStorageKey{AccessKey: "TESTACCESSKEY", SecretKey: "testsecretkey"}
+	standIn := newS3StandIn(key, "us-east-1")
+	server := httptest.NewServer(standIn)
+	defer server.Close()
+
+	badKey := lingo.ObjectStorageKey{AccessKey: "TESTACCESSKEY", SecretKey: "wrong"}
+	client := lingo.NewObjectClientWithEndpoint(server.URL, "us-east-1", badKey)
+
+	err := client.PutObject(lingo.PutObjectRequest{Bucket: "builds", Key: "a", Body: []byte("a")})
+	objErr, ok := errors.Cause(err).(lingo.ObjectError)
+	if !ok || objErr.StatusCode != http.StatusForbidden {
+		t.Fatalf("Expected signature to be rejected, but got: %v", err)
+	}
+}
+
+func Test_ObjectMultipartUpload(t *testing.T) {
+	client, standIn, cleanup := newTestObjectClient(t)
+	defer cleanup()
+
+	data := randomBytes(t, 12<<20)
+	req := lingo.UploadRequest{
+		Bucket:      "builds",
+		Key:         "images/disk.img",
+		Body:        bytes.NewReader(data),
+		Size:        int64(len(data)),
+		PartSize:    5 << 20,
+		Concurrency: 3,
+	}
+
+	if _, err := client.Upload(req); err != nil {
+		t.Fatalf("Failed to upload object: %s", err)
+	}
+
+	if standIn.partPuts != 3 {
+		t.Fatalf("Expected 3 parts to be uploaded, but got %d", standIn.partPuts)
+	}
+
+	if !bytes.Equal(standIn.objects["builds/images/disk.img"], data) {
+		t.Fatal("Uploaded object doesn't match source data")
+	}
+}
+
+func Test_ObjectResumeUpload(t *testing.T) {
+	client, standIn, cleanup := newTestObjectClient(t)
+	defer cleanup()
+
+	partSize := int64(5 << 20)
+	data := randomBytes(t, 11<<20)
+
+	upload, err := client.CreateMultipartUpload("builds", "images/resumed.img", "application/octet-stream")
+	if err != nil {
+		t.Fatalf("Failed to create multipart upload: %s", err)
+	}
+
+	if _, err := client.UploadPart(upload, 1, data[:partSize]); err != nil {
+		t.Fatalf("Failed to upload first part: %s", err)
+	}
+
+	req := lingo.UploadRequest{
+		Bucket:   "builds",
+		Key:      "images/resumed.img",
+		Body:     bytes.NewReader(data),
+		Size:     int64(len(data)),
+		PartSize: partSize,
+		UploadID: upload.UploadID,
+	}
+
+	if _, err := client.Upload(req); err != nil {
+		t.Fatalf("Failed to resume upload: %s", err)
+	}
+
+	if standIn.partPuts != 3 {
+		t.Fatalf("Expected already uploaded part to be skipped, but %d parts were sent", standIn.partPuts)
+	}
+
+	if !bytes.Equal(standIn.objects["builds/images/resumed.img"], data) {
+		t.Fatal("Resumed object doesn't match source data")
+	}
+}
+
+func Test_ObjectAbortUpload(t *testing.T) {
+	client, standIn, cleanup := newTestObjectClient(t)
+	defer cleanup()
+
+	upload, err := client.CreateMultipartUpload("builds", "images/aborted.img", "")
+	if err != nil {
+		t.Fatalf("Failed to create multipart upload: %s", err)
+	}
+
+	if err := client.AbortMultipartUpload(upload); err != nil {
+		t.Fatalf("Failed to abort upload: %s", err)
+	}
+
+	if len(standIn.uploads) != 0 {
+		t.Fatal("Expected aborted upload to be discarded")
+	}
+}
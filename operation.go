@@ -0,0 +1,156 @@
+package lingo
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// defaultOperationInterval is how often an Operation checks on its Event by default.
+const defaultOperationInterval = 5 * time.Second
+
+// An Operation tracks a long running action on a Linode, such as a migration, through the Event the
+// action records. The API doesn't say which Event an action created, so an Operation has to be
+// made before the action starts; it then tracks the first matching Event that shows up after that.
+type Operation struct {
+	events   Eventer
+	after    uint
+	LinodeID uint
+	Actions  []EventAction
+
+	// PollingInterval is how often Wait checks on the Event.
+	PollingInterval time.Duration
+}
+
+// NewOperation returns an Operation tracking the next Event with one of the actions on a Linode.
+func NewOperation(events Eventer, linodeID uint, actions ...EventAction) (Operation, error) {
+	op := Operation{
+		events:          events,
+		LinodeID:        linodeID,
+		Actions:         actions,
+		PollingInterval: defaultOperationInterval,
+	}
+
+	existing, err := op.list()
+	if err != nil {
+		return op, errors.Wrap(err, "failed to list events for NewOperation")
+	}
+
+	for _, event := range existing {
+		if event.ID > op.after {
+			op.after = event.ID
+		}
+	}
+
+	return op, nil
+}
+
+// Event retrieves the Event tracking the operation, reporting false if it hasn't shown up yet.
+func (o Operation) Event() (Event, bool, error) {
+	events, err := o.list()
+	if err != nil {
+		return Event{}, false, err
+	}
+
+	var tracked Event
+	for _, event := range events {
+		if event.ID > o.after && (tracked.ID == 0 || event.ID < tracked.ID) {
+			tracked = event
+		}
+	}
+
+	return tracked, tracked.ID != 0, nil
+}
+
+// Wait blocks until the operation finishes, calling progress, if given, with the Event each time
+// it's checked. An operation that fails returns its final Event along with an error.
+func (o Operation) Wait(ctx context.Context, progress func(Event)) (Event, error) {
+	for {
+		event, found, err := o.Event()
+		if err != nil {
+			return event, err
+		}
+
+		if found {
+			if progress != nil {
+				progress(event)
+			}
+
+			if event.Status == EventStatusFailed {
+				return event, errors.Errorf("%s of Linode %d failed: %s", event.Action, o.LinodeID, event.Message)
+			}
+
+			if event.Done() {
+				return event, nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return event, errors.Wrapf(ctx.Err(), "gave up waiting on Linode %d", o.LinodeID)
+		case <-time.After(o.PollingInterval):
+		}
+	}
+}
+
+// list retrieves the newest Events on the Linode with one of the operation's actions.
+func (o Operation) list() ([]Event, error) {
+	actions := make([]Filter, len(o.Actions))
+	for i, action := range o.Actions {
+		actions[i] = Filter{"action": action}
+	}
+
+	filter := Filter{
+		"+and": []Filter{
+			{"entity.type": "linode"},
+			{"entity.id": o.LinodeID},
+			{"+or": actions},
+		},
+		"+order_by": "created",
+		"+order":    "desc",
+	}
+
+	events, err := o.events.ListEventsFiltered(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the Events too rather than relying on the API to apply the whole filter.
+	var matched []Event
+	for _, event := range events {
+		if event.Entity == nil || event.Entity.Type != "linode" || event.Entity.ID != o.LinodeID {
+			continue
+		}
+
+		for _, action := range o.Actions {
+			if event.Action == action {
+				matched = append(matched, event)
+			}
+		}
+	}
+
+	return matched, nil
+}
+
+// waitForLinodeStatus polls a Linode until it has one of the statuses.
+func waitForLinodeStatus(ctx context.Context, linodes Linoder, linodeID uint, interval time.Duration, statuses ...Status) (Linode, error) {
+	for {
+		linode, err := linodes.ViewLinode(linodeID)
+		if err != nil {
+			return linode, err
+		}
+
+		for _, status := range statuses {
+			if linode.Status == status {
+				return linode, nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return linode, errors.Wrapf(ctx.Err(), "gave up waiting on Linode %s, which is %s", linode.Label, linode.Status)
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package lingo_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/eriktate/lingo"
+)
+
+// fakeEvents serves a list of Events that tests can change while an Operation waits on them.
+type fakeEvents struct {
+	mu     sync.Mutex
+	events []lingo.Event
+	polls  int
+	onPoll func(*fakeEvents)
+}
+
+func (f *fakeEvents) ListEvents() ([]lingo.Event, error) {
+	return f.ListEventsFiltered(nil)
+}
+
+func (f *fakeEvents) ListEventsFiltered(filter lingo.Filter) ([]lingo.Event, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.polls++
+	if f.onPoll != nil {
+		f.onPoll(f)
+	}
+
+	return append([]lingo.Event(nil), f.events...), nil
+}
+
+func (f *fakeEvents) ViewEvent(id uint) (lingo.Event, error) {
+	for _, event := range f.events {
+		if event.ID == id {
+			return event, nil
+		}
+	}
+
+	return lingo.Event{}, nil
+}
+
+func linodeEvent(id, linodeID uint, action lingo.EventAction, status lingo.EventStatus, percent uint) lingo.Event {
+	return lingo.Event{
+		ID:              id,
+		Action:          action,
+		Status:          status,
+		PercentComplete: percent,
+		Entity:          &lingo.EventEntity{ID: linodeID, Type: "linode"},
+	}
+}
+
+func Test_OperationWait(t *testing.T) {
+	events := &fakeEvents{events: []lingo.Event{
+		linodeEvent(10, 1, lingo.EventActionLinodeMigrate, lingo.EventStatusFinished, 100),
+	}}
+
+	op, err := lingo.NewOperation(events, 1, lingo.EventActionLinodeMigrate)
+	if err != nil {
+		t.Fatalf("Failed to create operation: %s", err)
+	}
+	op.PollingInterval = time.Millisecond
+
+	// The migration shows up after a poll, alongside noise from other Linodes and actions, and
+	// then makes progress each poll until it finishes.
+	events.onPoll = func(f *fakeEvents) {
+		switch f.polls {
+		case 3:
+			f.events = append([]lingo.Event{
+				linodeEvent(13, 2, lingo.EventActionLinodeMigrate, lingo.EventStatusStarted, 0),
+				linodeEvent(12, 1, lingo.EventActionLinodeBoot, lingo.EventStatusStarted, 0),
+				linodeEvent(11, 1, lingo.EventActionLinodeMigrate, lingo.EventStatusStarted, 10),
+			}, f.events...)
+		case 4, 5:
+			f.events[2].PercentComplete += 40
+		case 6:
+			f.events[2].PercentComplete = 100
+			f.events[2].Status = lingo.EventStatusFinished
+		}
+	}
+
+	var progress []uint
+	event, err := op.Wait(context.Background(), func(e lingo.Event) {
+		progress = append(progress, e.PercentComplete)
+	})
+	if err != nil {
+		t.Fatalf("Failed to wait on operation: %s", err)
+	}
+
+	if event.ID != 11 {
+		t.Fatalf("Expected to track event 11, but got %+v", event)
+	}
+
+	expected := []uint{10, 50, 90, 100}
+	if len(progress) != len(expected) {
+		t.Fatalf("Expected progress %v, but got %v", expected, progress)
+	}
+
+	for i := range expected {
+		if progress[i] != expected[i] {
+			t.Fatalf("Expected progress %v, but got %v", expected, progress)
+		}
+	}
+}
+
+func Test_OperationFailed(t *testing.T) {
+	events := &fakeEvents{}
+	op, _ := lingo.NewOperation(events, 1, lingo.EventActionLinodeRescue)
+	op.PollingInterval = time.Millisecond
+
+	failed := linodeEvent(1, 1, lingo.EventActionLinodeRescue, lingo.EventStatusFailed, 0)
+	failed.Message = "no disks"
+	events.events = []lingo.Event{failed}
+
+	if _, err := op.Wait(context.Background(), nil); err == nil {
+		t.Fatal("Expected a failed operation to return an error")
+	}
+}
+
+func Test_OperationTimeout(t *testing.T) {
+	events := &fakeEvents{}
+	op, _ := lingo.NewOperation(events, 1, lingo.EventActionLinodeMigrate)
+	op.PollingInterval = time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if _, err := op.Wait(ctx, nil); err == nil {
+		t.Fatal("Expected waiting on an event that never shows up to time out")
+	}
+}
@@ -0,0 +1,284 @@
+package lingo
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// A HostDNS gives Linodes matching forward and reverse DNS. It points A and AAAA records for a
+// Linode's public addresses at a name under a Domain, waits for the records to resolve, and then
+// sets the rDNS of each address to that name. Linode checks forward resolution before accepting
+// rDNS, which is why the wait is needed.
+type HostDNS struct {
+	linodes Linoder
+	domains Domainer
+	network Networker
+
+	// Nameservers are the servers, as host:port, that must all resolve the forward records before
+	// rDNS is set. They default to Linode's own nameservers.
+	Nameservers []string
+
+	// TTL is the TTL of created records. Zero uses the Domain's default.
+	TTL uint
+
+	// PropagationTimeout is how long to wait for forward records to resolve, and PollingInterval
+	// is how often to check on them.
+	PropagationTimeout time.Duration
+	PollingInterval    time.Duration
+}
+
+// NewHostDNS returns a new HostDNS given valid Linoder, Domainer and Networker implementations.
+func NewHostDNS(linodes Linoder, domains Domainer, network Networker) HostDNS {
+	return HostDNS{
+		linodes:            linodes,
+		domains:            domains,
+		network:            network,
+		Nameservers:        defaultNameservers(),
+		PropagationTimeout: 10 * time.Minute,
+		PollingInterval:    10 * time.Second,
+	}
+}
+
+// Bind gives a Linode forward and reverse DNS as name under the Domain, returning the addresses
+// with their updated rDNS. An empty name uses the Linode's label.
+func (h HostDNS) Bind(linode Linode, domainID uint, name string) ([]Address, error) {
+	domain, err := h.domains.ViewDomain(domainID)
+	if err != nil {
+		return nil, err
+	}
+
+	if name == "" {
+		name = hostLabel(linode.Label)
+	}
+
+	fqdn := strings.TrimSuffix(name+"."+domain.Domain, ".")
+	if !validHostname(fqdn) {
+		return nil, errors.Errorf("%q isn't a valid hostname for Linode %d", fqdn, linode.ID)
+	}
+
+	addrs := publicAddresses(linode)
+	if len(addrs) == 0 {
+		return nil, errors.Errorf("Linode %d has no public addresses", linode.ID)
+	}
+
+	if err := h.upsertRecords(domainID, name, addrs); err != nil {
+		return nil, err
+	}
+
+	if err := h.waitForAddresses(fqdn, addrs); err != nil {
+		return nil, err
+	}
+
+	updated := make([]Address, 0, len(addrs))
+	for _, addr := range addrs {
+		address, err := h.network.UpdateAddressRDNS(UpdateRDNSRequest{Address: addr.String(), RDNS: fqdn})
+		if err != nil {
+			return updated, errors.Wrapf(err, "failed to set rDNS for %s", addr)
+		}
+
+		updated = append(updated, address)
+	}
+
+	return updated, nil
+}
+
+// BindByTag binds every Linode carrying the tag, each using its label as its name. A failure for
+// one Linode doesn't stop the others, and the returned error lists every Linode that failed.
+func (h HostDNS) BindByTag(domainID uint, tag string) ([]Address, error) {
+	linodes, err := h.linodes.ListLinodesByTag(tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.bindAll(domainID, linodes)
+}
+
+// BindByLabel binds every Linode whose label matches a shell pattern such as "web-*", each using
+// its label as its name. Failures are handled the same way as BindByTag.
+func (h HostDNS) BindByLabel(domainID uint, pattern string) ([]Address, error) {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, errors.Wrapf(err, "invalid label pattern %q", pattern)
+	}
+
+	linodes, err := h.linodes.ListLinodes()
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []Linode
+	for _, linode := range linodes {
+		if ok, _ := path.Match(pattern, linode.Label); ok {
+			matched = append(matched, linode)
+		}
+	}
+
+	return h.bindAll(domainID, matched)
+}
+
+// bindAll binds each Linode in turn, collecting failures rather than stopping at the first one.
+func (h HostDNS) bindAll(domainID uint, linodes []Linode) ([]Address, error) {
+	var (
+		bound    []Address
+		failures []string
+	)
+
+	for _, linode := range linodes {
+		addrs, err := h.Bind(linode, domainID, "")
+		bound = append(bound, addrs...)
+		if err != nil {
+			failures = append(failures, linode.Label+": "+err.Error())
+		}
+	}
+
+	if len(failures) > 0 {
+		return bound, errors.Errorf("failed to bind %d of %d Linodes: %s", len(failures), len(linodes), strings.Join(failures, "; "))
+	}
+
+	return bound, nil
+}
+
+// upsertRecords makes sure there's an A or AAAA record for each address. Existing records for the
+// name that point at an address the Linode no longer has are repointed rather than duplicated, and
+// any left over are deleted.
+func (h HostDNS) upsertRecords(domainID uint, name string, addrs []netip.Addr) error {
+	records, err := h.domains.ListDomainRecords(domainID)
+	if err != nil {
+		return err
+	}
+
+	wanted := make(map[string]bool)
+	for _, addr := range addrs {
+		wanted[addr.String()] = true
+	}
+
+	var stale []DomainRecord
+	present := make(map[string]bool)
+	for _, record := range records {
+		if (record.Type != A && record.Type != AAAA) || !strings.EqualFold(record.Name, name) {
+			continue
+		}
+
+		target := normalizeTarget(record)
+		if wanted[target] {
+			present[target] = true
+		} else {
+			stale = append(stale, record)
+		}
+	}
+
+	for _, addr := range addrs {
+		if present[addr.String()] {
+			continue
+		}
+
+		record := NewARecord(name, addr.String())
+		if addr.Is6() {
+			record = NewAAAARecord(name, addr.String())
+		}
+		record.TTLSec = h.TTL
+
+		// Reuse a stale record of the same type if there is one.
+		reused := false
+		for i, old := range stale {
+			if old.Type == record.Type {
+				record.ID = old.ID
+				stale = append(stale[:i], stale[i+1:]...)
+				reused = true
+				break
+			}
+		}
+
+		if reused {
+			_, err = h.domains.UpdateDomainRecord(domainID, record)
+		} else {
+			_, err = h.domains.CreateDomainRecord(domainID, record)
+		}
+
+		if err != nil {
+			return errors.Wrapf(err, "failed to point %s at %s", name, addr)
+		}
+	}
+
+	// Whatever wasn't reused points at an address the Linode no longer has.
+	for _, record := range stale {
+		if err := h.domains.DeleteDomainRecord(domainID, record.ID); err != nil {
+			return errors.Wrapf(err, "failed to remove stale %s record for %s pointing at %s", record.Type, name, record.Target)
+		}
+	}
+
+	return nil
+}
+
+// waitForAddresses polls each nameserver until they all resolve the FQDN to every address.
+func (h HostDNS) waitForAddresses(fqdn string, addrs []netip.Addr) error {
+	ctx, cancel := context.WithTimeout(context.Background(), h.PropagationTimeout)
+	defer cancel()
+
+	err := waitForNameservers(ctx, h.Nameservers, h.PollingInterval, func(ctx context.Context, resolver *net.Resolver) bool {
+		for _, network := range []string{"ip4", "ip6"} {
+			var want []netip.Addr
+			for _, addr := range addrs {
+				if addr.Is4() == (network == "ip4") {
+					want = append(want, addr)
+				}
+			}
+
+			if len(want) == 0 {
+				continue
+			}
+
+			resolved, err := resolver.LookupNetIP(ctx, network, absoluteName(fqdn))
+			if err != nil {
+				return false
+			}
+
+			for _, addr := range want {
+				found := false
+				for _, r := range resolved {
+					found = found || r.Unmap() == addr
+				}
+
+				if !found {
+					return false
+				}
+			}
+		}
+
+		return true
+	})
+
+	return errors.Wrapf(err, "%s didn't resolve", fqdn)
+}
+
+// publicAddresses returns the public IPv4 addresses and the SLAAC IPv6 address of a Linode.
+func publicAddresses(linode Linode) []netip.Addr {
+	var addrs []netip.Addr
+	for _, ip := range linode.IPv4 {
+		addr, err := netip.ParseAddr(ip)
+		if err == nil && addr.Is4() && !addr.IsPrivate() {
+			addrs = append(addrs, addr)
+		}
+	}
+
+	if linode.IPv6 != "" {
+		if prefix, err := netip.ParsePrefix(linode.IPv6); err == nil {
+			addrs = append(addrs, prefix.Addr())
+		} else if addr, err := netip.ParseAddr(linode.IPv6); err == nil {
+			addrs = append(addrs, addr)
+		}
+	}
+
+	return addrs
+}
+
+// hostLabel turns a Linode label into a DNS label. Linode allows underscores and mixed case in
+// labels, neither of which belong in a hostname.
+func hostLabel(label string) string {
+	return strings.ToLower(strings.Replace(label, "_", "-", -1))
+}
@@ -0,0 +1,196 @@
+package lingo_test
+
+import (
+	"net/netip"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/eriktate/lingo"
+	"github.com/pkg/errors"
+)
+
+// fakeLinodes serves a fixed set of Linodes. Methods the tests don't need panic through the nil
+// embedded interface.
+type fakeLinodes struct {
+	lingo.Linoder
+	linodes []lingo.Linode
+}
+
+func (f fakeLinodes) ListLinodes() ([]lingo.Linode, error) {
+	return f.linodes, nil
+}
+
+func (f fakeLinodes) ViewLinode(id uint) (lingo.Linode, error) {
+	for _, linode := range f.linodes {
+		if linode.ID == id {
+			return linode, nil
+		}
+	}
+
+	return lingo.Linode{}, errors.Errorf("no Linode with ID %d", id)
+}
+
+func (f fakeLinodes) ListLinodesByTag(tag string) ([]lingo.Linode, error) {
+	var tagged []lingo.Linode
+	for _, linode := range f.linodes {
+		for _, t := range linode.Tags {
+			if t == tag {
+				tagged = append(tagged, linode)
+			}
+		}
+	}
+
+	return tagged, nil
+}
+
+// fakeNetwork records rDNS updates.
+type fakeNetwork struct {
+	lingo.Networker
+	mu   sync.Mutex
+	rdns map[string]string
+}
+
+func (f *fakeNetwork) UpdateAddressRDNS(req lingo.UpdateRDNSRequest) (lingo.Address, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.rdns[req.Address] = req.RDNS
+	return lingo.Address{Address: req.Address, RDNS: req.RDNS}, nil
+}
+
+// newHostDNSFixture wires a HostDNS up to fakes and a DNS stand-in that serves the fake domain's A
+// and AAAA records.
+func newHostDNSFixture(t *testing.T, linodes []lingo.Linode) (lingo.HostDNS, *fakeDomains, *fakeNetwork) {
+	domains := &fakeDomains{
+		domains: []lingo.Domain{{ID: 1, Domain: "example.com", Type: lingo.DomainTypeMaster}},
+		records: []lingo.DomainRecord{
+			{ID: 1, Type: lingo.A, Name: "web-1", Target: "192.0.2.99"},
+			{ID: 2, Type: lingo.TXT, Name: "web-1", Target: "unrelated"},
+		},
+	}
+
+	standIn := newDNSStandIn(t, func(name string, qtype uint16) [][]byte {
+		records, _ := domains.ListDomainRecords(1)
+		var answers [][]byte
+		for _, record := range records {
+			if strings.ToLower(record.Name)+".example.com." != name {
+				continue
+			}
+
+			addr, err := netip.ParseAddr(record.Target)
+			if err != nil {
+				continue
+			}
+
+			if (qtype == 1 && record.Type == lingo.A) || (qtype == 28 && record.Type == lingo.AAAA) {
+				answers = append(answers, addr.AsSlice())
+			}
+		}
+
+		return answers
+	})
+
+	network := &fakeNetwork{rdns: make(map[string]string)}
+	h := lingo.NewHostDNS(fakeLinodes{linodes: linodes}, domains, network)
+	h.Nameservers = []string{standIn.addr()}
+	h.PropagationTimeout = 5 * time.Second
+	h.PollingInterval = 10 * time.Millisecond
+
+	return h, domains, network
+}
+
+func testHostLinodes() []lingo.Linode {
+	return []lingo.Linode{
+		{ID: 1, Label: "web_1", IPv4: []string{"192.0.2.10", "192.168.130.5"}, IPv6: "2001:db8::f03c:91ff:fe00:1/128", Tags: []string{"web"}},
+		{ID: 2, Label: "web-2", IPv4: []string{"192.0.2.20"}, Tags: []string{"web"}},
+		{ID: 3, Label: "db-1", IPv4: []string{"192.0.2.30"}},
+	}
+}
+
+func Test_HostDNSBind(t *testing.T) {
+	h, domains, network := newHostDNSFixture(t, nil)
+
+	addrs, err := h.Bind(testHostLinodes()[0], 1, "")
+	if err != nil {
+		t.Fatalf("Failed to bind Linode: %s", err)
+	}
+
+	if len(addrs) != 2 {
+		t.Fatalf("Expected rDNS for the public IPv4 and IPv6 addresses, but got %+v", addrs)
+	}
+
+	for _, ip := range []string{"192.0.2.10", "2001:db8::f03c:91ff:fe00:1"} {
+		if network.rdns[ip] != "web-1.example.com" {
+			t.Fatalf("Expected rDNS for %s to be web-1.example.com, but got %q", ip, network.rdns[ip])
+		}
+	}
+
+	if _, ok := network.rdns["192.168.130.5"]; ok {
+		t.Fatal("Private addresses shouldn't get rDNS")
+	}
+
+	records, _ := domains.ListDomainRecords(1)
+	if len(records) != 3 {
+		t.Fatalf("Expected the stale A record to be reused and an AAAA record created, but got %+v", records)
+	}
+
+	if records[0].ID != 1 || records[0].Target != "192.0.2.10" {
+		t.Fatalf("Expected the existing A record to be repointed, but got %+v", records[0])
+	}
+
+	if records[2].Type != lingo.AAAA || records[2].Name != "web-1" {
+		t.Fatalf("Expected a new AAAA record, but got %+v", records[2])
+	}
+}
+
+func Test_HostDNSBindByTagAndLabel(t *testing.T) {
+	h, _, network := newHostDNSFixture(t, testHostLinodes())
+
+	if _, err := h.BindByTag(1, "web"); err != nil {
+		t.Fatalf("Failed to bind by tag: %s", err)
+	}
+
+	if network.rdns["192.0.2.20"] != "web-2.example.com" || len(network.rdns) != 3 {
+		t.Fatalf("Expected both web Linodes to be bound, but got %v", network.rdns)
+	}
+
+	if _, err := h.BindByLabel(1, "db-*"); err != nil {
+		t.Fatalf("Failed to bind by label: %s", err)
+	}
+
+	if network.rdns["192.0.2.30"] != "db-1.example.com" {
+		t.Fatalf("Expected db-1 to be bound, but got %v", network.rdns)
+	}
+
+	if _, err := h.BindByLabel(1, "[web"); err == nil {
+		t.Fatal("Expected an invalid pattern to be rejected")
+	}
+}
+
+func Test_HostDNSBindRemovesStaleRecords(t *testing.T) {
+	h, domains, _ := newHostDNSFixture(t, nil)
+	domains.records = append(domains.records, lingo.DomainRecord{ID: 3, Type: lingo.A, Name: "web-1", Target: "192.0.2.98"})
+
+	if _, err := h.Bind(lingo.Linode{ID: 1, Label: "web-1", IPv4: []string{"192.0.2.10"}}, 1, ""); err != nil {
+		t.Fatalf("Failed to bind Linode: %s", err)
+	}
+
+	records, _ := domains.ListDomainRecords(1)
+	if len(records) != 2 {
+		t.Fatalf("Expected the leftover A record to be deleted, but got %+v", records)
+	}
+
+	if records[0].ID != 1 || records[0].Target != "192.0.2.10" || records[1].Type != lingo.TXT {
+		t.Fatalf("Expected the first A record to be repointed and the TXT record kept, but got %+v", records)
+	}
+}
+
+func Test_HostDNSNoPublicAddress(t *testing.T) {
+	h, _, _ := newHostDNSFixture(t, nil)
+
+	if _, err := h.Bind(lingo.Linode{ID: 9, Label: "private", IPv4: []string{"192.168.1.1"}}, 1, ""); err == nil {
+		t.Fatal("Expected binding a Linode without public addresses to fail")
+	}
+}
@@ -0,0 +1,269 @@
+package lingo
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// A RecordAction is an enumeration of the changes a Reconciler can make to a Domain Record.
+type RecordAction string
+
+// Enum values for RecordAction.
+const (
+	RecordActionCreate = RecordAction("create")
+	RecordActionUpdate = RecordAction("update")
+	RecordActionDelete = RecordAction("delete")
+)
+
+// A RecordChange is a single change needed to bring a Domain's records in line with the desired
+// set. Current is empty for creates, and Desired is empty for deletes.
+type RecordChange struct {
+	Action  RecordAction
+	Current DomainRecord
+	Desired DomainRecord
+}
+
+// A ReconcilePlan is the set of changes needed to reconcile a Domain's records. Changes are ordered
+// creates, then updates, then deletes, so a partially applied plan never removes a record before
+// its replacement exists.
+type ReconcilePlan struct {
+	DomainID uint
+	Changes  []RecordChange
+}
+
+// A Reconciler makes the records of a Domain match a desired record set. Records are matched by
+// type, name and target, with SRV records also matched by service and protocol. CNAME records are
+// matched by name alone since a name can only have one, so changing a CNAME's target is an update.
+// With NeverDelete set, records that aren't in the desired set are left alone.
+type Reconciler struct {
+	domains     Domainer
+	NeverDelete bool
+}
+
+// NewReconciler returns a new Reconciler given a valid Domainer.
+func NewReconciler(domains Domainer) Reconciler {
+	return Reconciler{domains: domains}
+}
+
+// Plan compares the desired records against the records currently in a Domain and returns the
+// changes needed to reconcile them without making any of them.
+func (r Reconciler) Plan(domainID uint, desired []DomainRecord) (ReconcilePlan, error) {
+	plan := ReconcilePlan{DomainID: domainID}
+
+	current, err := r.domains.ListDomainRecords(domainID)
+	if err != nil {
+		return plan, err
+	}
+
+	existing := make(map[string][]DomainRecord)
+	for _, record := range current {
+		key := recordKey(record)
+		existing[key] = append(existing[key], record)
+	}
+
+	var creates, updates, deletes []RecordChange
+	seen := make(map[string]bool)
+	for _, want := range desired {
+		if err := want.Validate(); err != nil {
+			return plan, err
+		}
+
+		key := recordKey(want)
+		if seen[key] {
+			return plan, errors.Errorf("desired records contain more than one %s record for %q", want.Type, recordOwner(want))
+		}
+		seen[key] = true
+
+		matches := existing[key]
+		if len(matches) == 0 {
+			creates = append(creates, RecordChange{Action: RecordActionCreate, Desired: want})
+			continue
+		}
+
+		have := matches[0]
+		if !recordsEqual(have, want) {
+			want.ID = have.ID
+			updates = append(updates, RecordChange{Action: RecordActionUpdate, Current: have, Desired: want})
+		}
+
+		// Any duplicates of a desired record are surplus.
+		existing[key] = matches[1:]
+	}
+
+	if !r.NeverDelete {
+		surplus := make(map[uint]bool)
+		for _, records := range existing {
+			for _, record := range records {
+				surplus[record.ID] = true
+			}
+		}
+
+		for _, record := range current {
+			if surplus[record.ID] {
+				deletes = append(deletes, RecordChange{Action: RecordActionDelete, Current: record})
+			}
+		}
+	}
+
+	plan.Changes = append(append(creates, updates...), deletes...)
+	return plan, nil
+}
+
+// Apply makes each of the changes in a plan. It stops at the first change that fails, and the
+// returned error says which change it was. Deletes are skipped when NeverDelete is set, even if
+// the plan was made by a Reconciler without it.
+func (r Reconciler) Apply(plan ReconcilePlan) error {
+	for _, change := range plan.Changes {
+		var err error
+		switch change.Action {
+		case RecordActionCreate:
+			_, err = r.domains.CreateDomainRecord(plan.DomainID, change.Desired)
+		case RecordActionUpdate:
+			_, err = r.domains.UpdateDomainRecord(plan.DomainID, change.Desired)
+		case RecordActionDelete:
+			if r.NeverDelete {
+				continue
+			}
+
+			err = r.domains.DeleteDomainRecord(plan.DomainID, change.Current.ID)
+		default:
+			err = errors.Errorf("unknown action %q", change.Action)
+		}
+
+		if err != nil {
+			return errors.Wrapf(err, "failed to apply change: %s", change)
+		}
+	}
+
+	return nil
+}
+
+// Reconcile plans and then applies the changes needed to make a Domain's records match the desired
+// set, returning the plan that was applied.
+func (r Reconciler) Reconcile(domainID uint, desired []DomainRecord) (ReconcilePlan, error) {
+	plan, err := r.Plan(domainID, desired)
+	if err != nil {
+		return plan, err
+	}
+
+	return plan, r.Apply(plan)
+}
+
+// Empty reports whether the plan has no changes to make.
+func (p ReconcilePlan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// String renders the plan for a dry run, one change per line with +, ~ and - marking creates,
+// updates and deletes.
+func (p ReconcilePlan) String() string {
+	if p.Empty() {
+		return fmt.Sprintf("Domain %d: no changes\n", p.DomainID)
+	}
+
+	counts := make(map[RecordAction]int)
+	for _, change := range p.Changes {
+		counts[change.Action]++
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Domain %d: %d to create, %d to update, %d to delete\n",
+		p.DomainID, counts[RecordActionCreate], counts[RecordActionUpdate], counts[RecordActionDelete])
+
+	for _, change := range p.Changes {
+		b.WriteString(change.String())
+		b.WriteByte('\n')
+	}
+
+	return b.String()
+}
+
+// String describes a single change.
+func (c RecordChange) String() string {
+	switch c.Action {
+	case RecordActionCreate:
+		return "+ " + describeRecord(c.Desired)
+	case RecordActionDelete:
+		return "- " + describeRecord(c.Current)
+	case RecordActionUpdate:
+		return fmt.Sprintf("~ %s (%s)", describeRecord(c.Current), strings.Join(recordDiff(c.Current, c.Desired), ", "))
+	default:
+		return fmt.Sprintf("? %s", c.Action)
+	}
+}
+
+// describeRecord renders a record on a single line for plan output.
+func describeRecord(record DomainRecord) string {
+	owner := recordOwner(record)
+	if owner == "" {
+		owner = "@"
+	}
+
+	target := record.Target
+	if record.Type == TXT || record.Type == CAA {
+		target = fmt.Sprintf("%q", target)
+	}
+
+	return fmt.Sprintf("%s %s %s", record.Type, owner, target)
+}
+
+// recordDiff lists the fields that differ between two records.
+func recordDiff(current, desired DomainRecord) []string {
+	var diff []string
+	field := func(name string, from, to interface{}) {
+		if from != to {
+			diff = append(diff, fmt.Sprintf("%s %v -> %v", name, from, to))
+		}
+	}
+
+	field("target", normalizeTarget(current), normalizeTarget(desired))
+	field("ttl", current.TTLSec, desired.TTLSec)
+	field("priority", current.Priority, desired.Priority)
+	field("weight", current.Weight, desired.Weight)
+	field("port", current.Port, desired.Port)
+	field("tag", current.Tag, desired.Tag)
+
+	sort.Strings(diff)
+	return diff
+}
+
+// recordsEqual reports whether two records with the same key would serve the same data.
+func recordsEqual(current, desired DomainRecord) bool {
+	return len(recordDiff(current, desired)) == 0
+}
+
+// recordKey identifies the record a desired record should be matched against.
+func recordKey(record DomainRecord) string {
+	owner := strings.ToLower(recordOwner(record))
+	if record.Type == CNAME {
+		return fmt.Sprintf("%s|%s", record.Type, owner)
+	}
+
+	key := fmt.Sprintf("%s|%s|%s", record.Type, owner, normalizeTarget(record))
+	if record.Type == CAA {
+		key += "|" + strings.ToLower(record.Tag)
+	}
+
+	return key
+}
+
+// normalizeTarget returns a record's target in a canonical form for comparison. IP addresses are
+// compared by value and hostnames without case or a trailing dot.
+func normalizeTarget(record DomainRecord) string {
+	switch record.Type {
+	case A, AAAA:
+		if ip := net.ParseIP(record.Target); ip != nil {
+			return ip.String()
+		}
+
+		return record.Target
+	case TXT, CAA:
+		return record.Target
+	default:
+		return strings.ToLower(strings.TrimSuffix(record.Target, "."))
+	}
+}
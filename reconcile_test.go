@@ -0,0 +1,217 @@
+package lingo_test
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/eriktate/lingo"
+)
+
+// fakeDomains is an in-memory lingo.Domainer. Every domain shares the same set of records.
+type fakeDomains struct {
+	mu      sync.Mutex
+	domains []lingo.Domain
+	records []lingo.DomainRecord
+	nextID  uint
+	calls   []string
+}
+
+func (f *fakeDomains) ListDomains() ([]lingo.Domain, error)                { return f.domains, nil }
+func (f *fakeDomains) ListDomainsByTag(tag string) ([]lingo.Domain, error) { return nil, nil }
+func (f *fakeDomains) CreateDomain(d lingo.Domain) (lingo.Domain, error)   { return d, nil }
+func (f *fakeDomains) UpdateDomain(req lingo.UpdateDomainRequest) (lingo.Domain, error) {
+	return lingo.Domain{ID: req.ID, Description: req.Description}, nil
+}
+func (f *fakeDomains) DeleteDomain(id uint) error { return nil }
+
+func (f *fakeDomains) CloneDomain(id uint, domain string) (lingo.Domain, error) {
+	return lingo.Domain{Domain: domain}, nil
+}
+
+func (f *fakeDomains) ViewDomain(id uint) (lingo.Domain, error) {
+	for _, domain := range f.domains {
+		if domain.ID == id {
+			return domain, nil
+		}
+	}
+
+	return lingo.Domain{ID: id}, nil
+}
+
+func (f *fakeDomains) ListDomainRecords(domainID uint) ([]lingo.DomainRecord, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]lingo.DomainRecord(nil), f.records...), nil
+}
+
+func (f *fakeDomains) ViewDomainRecord(domainID, recordID uint) (lingo.DomainRecord, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, record := range f.records {
+		if record.ID == recordID {
+			return record, nil
+		}
+	}
+
+	return lingo.DomainRecord{}, errors.New("not found")
+}
+
+func (f *fakeDomains) CreateDomainRecord(domainID uint, record lingo.DomainRecord) (lingo.DomainRecord, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.nextID++
+	record.ID = 1000 + f.nextID
+	f.records = append(f.records, record)
+	f.calls = append(f.calls, "create")
+	return record, nil
+}
+
+func (f *fakeDomains) UpdateDomainRecord(domainID uint, record lingo.DomainRecord) (lingo.DomainRecord, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i := range f.records {
+		if f.records[i].ID == record.ID {
+			f.records[i] = record
+			f.calls = append(f.calls, "update")
+			return record, nil
+		}
+	}
+
+	return record, errors.New("not found")
+}
+
+func (f *fakeDomains) DeleteDomainRecord(domainID, recordID uint) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i := range f.records {
+		if f.records[i].ID == recordID {
+			f.records = append(f.records[:i], f.records[i+1:]...)
+			f.calls = append(f.calls, "delete")
+			return nil
+		}
+	}
+
+	return errors.New("not found")
+}
+
+func testCurrentRecords() []lingo.DomainRecord {
+	return []lingo.DomainRecord{
+		{ID: 1, Type: lingo.A, Name: "www", Target: "192.0.2.1", TTLSec: 300},
+		{ID: 2, Type: lingo.CNAME, Name: "blog", Target: "old.example.net"},
+		{ID: 3, Type: lingo.TXT, Target: "stale"},
+		{ID: 4, Type: lingo.MX, Target: "mail.example.com", Priority: 10},
+		{ID: 5, Type: lingo.MX, Target: "MAIL.example.com.", Priority: 10},
+	}
+}
+
+func testDesiredRecords() []lingo.DomainRecord {
+	return []lingo.DomainRecord{
+		{Type: lingo.A, Name: "www", Target: "192.0.2.1", TTLSec: 300},
+		{Type: lingo.CNAME, Name: "blog", Target: "new.example.net"},
+		{Type: lingo.MX, Target: "mail.example.com", Priority: 20},
+		{Type: lingo.SRV, Service: "sip", Protocol: "tcp", Target: "sip.example.com", Port: 5060},
+	}
+}
+
+func Test_ReconcilePlan(t *testing.T) {
+	domains := &fakeDomains{records: testCurrentRecords()}
+	reconciler := lingo.NewReconciler(domains)
+
+	plan, err := reconciler.Plan(7, testDesiredRecords())
+	if err != nil {
+		t.Fatalf("Failed to plan: %s", err)
+	}
+
+	var actions []string
+	for _, change := range plan.Changes {
+		actions = append(actions, string(change.Action))
+	}
+
+	expected := "create update update delete delete"
+	if strings.Join(actions, " ") != expected {
+		t.Fatalf("Expected actions %q, but got %q", expected, strings.Join(actions, " "))
+	}
+
+	if plan.Changes[1].Desired.ID != 2 || plan.Changes[2].Desired.ID != 4 {
+		t.Fatal("Expected updates to carry the IDs of the records they replace")
+	}
+
+	if plan.Changes[3].Current.ID != 3 || plan.Changes[4].Current.ID != 5 {
+		t.Fatal("Expected the stale TXT and the duplicate MX to be deleted")
+	}
+
+	output := plan.String()
+	for _, line := range []string{
+		"Domain 7: 1 to create, 2 to update, 2 to delete",
+		"+ SRV _sip._tcp sip.example.com",
+		"~ CNAME blog old.example.net (target old.example.net -> new.example.net)",
+		"~ MX @ mail.example.com (priority 10 -> 20)",
+		`- TXT @ "stale"`,
+	} {
+		if !strings.Contains(output, line) {
+			t.Fatalf("Expected plan output to contain %q, got:\n%s", line, output)
+		}
+	}
+
+	if len(domains.calls) != 0 {
+		t.Fatal("Planning shouldn't change any records")
+	}
+}
+
+func Test_ReconcileApply(t *testing.T) {
+	domains := &fakeDomains{records: testCurrentRecords()}
+	reconciler := lingo.NewReconciler(domains)
+
+	if _, err := reconciler.Reconcile(7, testDesiredRecords()); err != nil {
+		t.Fatalf("Failed to reconcile: %s", err)
+	}
+
+	plan, err := reconciler.Plan(7, testDesiredRecords())
+	if err != nil {
+		t.Fatalf("Failed to plan: %s", err)
+	}
+
+	if !plan.Empty() {
+		t.Fatalf("Expected nothing left to do after reconciling, got:\n%s", plan)
+	}
+}
+
+func Test_ReconcileNeverDelete(t *testing.T) {
+	domains := &fakeDomains{records: testCurrentRecords()}
+	reconciler := lingo.NewReconciler(domains)
+	reconciler.NeverDelete = true
+
+	plan, err := reconciler.Reconcile(7, testDesiredRecords())
+	if err != nil {
+		t.Fatalf("Failed to reconcile: %s", err)
+	}
+
+	for _, change := range plan.Changes {
+		if change.Action == lingo.RecordActionDelete {
+			t.Fatal("Expected no deletes to be planned")
+		}
+	}
+
+	if len(domains.records) != 6 {
+		t.Fatalf("Expected all 5 existing records plus 1 new one, but got %d", len(domains.records))
+	}
+}
+
+func Test_ReconcileDuplicateDesired(t *testing.T) {
+	reconciler := lingo.NewReconciler(&fakeDomains{})
+	desired := []lingo.DomainRecord{
+		{Type: lingo.CNAME, Name: "www", Target: "a.example.net"},
+		{Type: lingo.CNAME, Name: "WWW", Target: "b.example.net"},
+	}
+
+	if _, err := reconciler.Plan(7, desired); err == nil {
+		t.Fatal("Expected conflicting CNAMEs to be rejected")
+	}
+}
@@ -0,0 +1,232 @@
+package lingo
+
+import (
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// The TTL bounds Linode accepts for a Domain Record. A TTL of zero uses the Domain's default.
+const (
+	MinRecordTTL = 30
+	MaxRecordTTL = 2419200
+)
+
+// NewARecord returns an A record pointing a name at an IPv4 address.
+func NewARecord(name, ip string) DomainRecord {
+	return DomainRecord{Type: A, Name: name, Target: ip}
+}
+
+// NewAAAARecord returns an AAAA record pointing a name at an IPv6 address.
+func NewAAAARecord(name, ip string) DomainRecord {
+	return DomainRecord{Type: AAAA, Name: name, Target: ip}
+}
+
+// NewCNAMERecord returns a CNAME record aliasing a name to another hostname.
+func NewCNAMERecord(name, target string) DomainRecord {
+	return DomainRecord{Type: CNAME, Name: name, Target: target}
+}
+
+// NewMXRecord returns an MX record sending mail for a name to a mail server.
+func NewMXRecord(name, target string, priority uint8) DomainRecord {
+	return DomainRecord{Type: MX, Name: name, Target: target, Priority: priority}
+}
+
+// NewTXTRecord returns a TXT record holding arbitrary text.
+func NewTXTRecord(name, text string) DomainRecord {
+	return DomainRecord{Type: TXT, Name: name, Target: text}
+}
+
+// NewNSRecord returns an NS record delegating a name to a nameserver.
+func NewNSRecord(name, target string) DomainRecord {
+	return DomainRecord{Type: NS, Name: name, Target: target}
+}
+
+// NewPTRRecord returns a PTR record pointing a name back at a hostname.
+func NewPTRRecord(name, target string) DomainRecord {
+	return DomainRecord{Type: PTR, Name: name, Target: target}
+}
+
+// NewSRVRecord returns an SRV record advertising a service. The service and protocol are given
+// without their leading underscores, e.g. "sip" and "tcp".
+func NewSRVRecord(service, protocol, target string, priority uint8, weight, port uint) DomainRecord {
+	return DomainRecord{
+		Type:     SRV,
+		Service:  service,
+		Protocol: protocol,
+		Target:   target,
+		Priority: priority,
+		Weight:   weight,
+		Port:     port,
+	}
+}
+
+// NewCAARecord returns a CAA record restricting which certificate authorities may issue for a
+// name. The tag is one of "issue", "issuewild" or "iodef".
+func NewCAARecord(name, tag, value string) DomainRecord {
+	return DomainRecord{Type: CAA, Name: name, Tag: tag, Target: value}
+}
+
+// WithTTL returns a copy of the record with its TTL set.
+func (r DomainRecord) WithTTL(ttl uint) DomainRecord {
+	r.TTLSec = ttl
+	return r
+}
+
+// Validate checks that a record has everything its type needs before it's sent to Linode, so that
+// mistakes are caught with a useful error rather than a 400.
+func (r DomainRecord) Validate() error {
+	if !ValidateDomainRecordType(string(r.Type)) {
+		return errors.Errorf("%q isn't a supported record type", r.Type)
+	}
+
+	if r.TTLSec != 0 && (r.TTLSec < MinRecordTTL || r.TTLSec > MaxRecordTTL) {
+		return errors.Errorf("%s record TTL %d must be between %d and %d seconds", r.Type, r.TTLSec, MinRecordTTL, MaxRecordTTL)
+	}
+
+	if r.Name != "" && r.Type != SRV && !validRecordName(r.Name) {
+		return errors.Errorf("%s record name %q isn't a valid name", r.Type, r.Name)
+	}
+
+	switch r.Type {
+	case A:
+		ip := net.ParseIP(r.Target)
+		if ip == nil || ip.To4() == nil || strings.Contains(r.Target, ":") {
+			return errors.Errorf("A record target %q isn't an IPv4 address", r.Target)
+		}
+	case AAAA:
+		ip := net.ParseIP(r.Target)
+		if ip == nil || !strings.Contains(r.Target, ":") {
+			return errors.Errorf("AAAA record target %q isn't an IPv6 address", r.Target)
+		}
+	case CNAME:
+		if r.Name == "" {
+			return errors.New("CNAME records can't be created at the zone apex")
+		}
+
+		if !validTarget(r.Target) {
+			return errors.Errorf("CNAME record target %q isn't a valid hostname", r.Target)
+		}
+	case NS, MX, PTR:
+		if !validTarget(r.Target) {
+			return errors.Errorf("%s record target %q isn't a valid hostname", r.Type, r.Target)
+		}
+	case TXT:
+		if r.Target == "" {
+			return errors.New("TXT record has no text")
+		}
+	case SRV:
+		return r.validateSRV()
+	case CAA:
+		return r.validateCAA()
+	}
+
+	return nil
+}
+
+// validateSRV checks the service, protocol, port and target of an SRV record.
+func (r DomainRecord) validateSRV() error {
+	service := strings.TrimPrefix(r.Service, "_")
+	protocol := strings.TrimPrefix(r.Protocol, "_")
+	if service == "" || protocol == "" {
+		return errors.New("SRV record needs both a service and a protocol")
+	}
+
+	if !validLabel(service) || !validLabel(protocol) {
+		return errors.Errorf("SRV record owner _%s._%s isn't of the form _service._proto", service, protocol)
+	}
+
+	if r.Name != "" && !strings.HasPrefix(r.Name, "_") && !validRecordName(r.Name) {
+		return errors.Errorf("SRV record name %q isn't a valid name", r.Name)
+	}
+
+	// A target of "." means the service isn't available at the domain, and its port is usually 0.
+	if (r.Port == 0 && r.Target != ".") || r.Port > 65535 {
+		return errors.Errorf("SRV record port %d must be between 1 and 65535", r.Port)
+	}
+
+	if r.Weight > 65535 {
+		return errors.Errorf("SRV record weight %d must be at most 65535", r.Weight)
+	}
+
+	if r.Target != "." && !validTarget(r.Target) {
+		return errors.Errorf("SRV record target %q isn't a valid hostname", r.Target)
+	}
+
+	return nil
+}
+
+// validateCAA checks the tag and value of a CAA record.
+func (r DomainRecord) validateCAA() error {
+	switch r.Tag {
+	case "issue", "issuewild":
+		// An issuer of ";" forbids issuance altogether.
+		issuer := strings.TrimSpace(strings.SplitN(r.Target, ";", 2)[0])
+		if issuer != "" && !validHostname(issuer) {
+			return errors.Errorf("CAA %s value %q doesn't name a valid issuer", r.Tag, r.Target)
+		}
+
+		if issuer == "" && !strings.HasPrefix(strings.TrimSpace(r.Target), ";") {
+			return errors.Errorf("CAA %s record has no value", r.Tag)
+		}
+	case "iodef":
+		u, err := url.Parse(r.Target)
+		if err != nil || (u.Scheme != "mailto" && u.Scheme != "http" && u.Scheme != "https") {
+			return errors.Errorf("CAA iodef value %q must be a mailto:, http: or https: URL", r.Target)
+		}
+	default:
+		return errors.Errorf("CAA record tag %q must be one of issue, issuewild or iodef", r.Tag)
+	}
+
+	return nil
+}
+
+// validHostname reports whether a name is a valid fully qualified or relative hostname.
+func validHostname(name string) bool {
+	name = strings.TrimSuffix(name, ".")
+	if name == "" || len(name) > 253 {
+		return false
+	}
+
+	for _, label := range strings.Split(name, ".") {
+		if !validLabel(label) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// validRecordName reports whether a name is valid as the owner of a record. Unlike hostnames,
+// owner names can start with a wildcard and include underscores, as in "_dmarc".
+func validRecordName(name string) bool {
+	name = strings.TrimPrefix(name, "*.")
+	if name == "*" {
+		return true
+	}
+
+	return validHostname(strings.Replace(name, "_", "a", -1))
+}
+
+// validTarget reports whether a name is valid as the target of a record. Targets are usually
+// hostnames, but can point at names with underscores, as in a "_domainkey" CNAME.
+func validTarget(name string) bool {
+	return validHostname(strings.Replace(name, "_", "a", -1))
+}
+
+// validLabel reports whether a single DNS label is made up of letters, digits and inner hyphens.
+func validLabel(label string) bool {
+	if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+		return false
+	}
+
+	for _, c := range label {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-') {
+			return false
+		}
+	}
+
+	return true
+}
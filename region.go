@@ -1,9 +1,36 @@
 package lingo
 
+// Region capabilities lingo checks for.
+const (
+	RegionCapabilityMetadata = "Metadata"
+	RegionCapabilityVPCs     = "VPCs"
+)
+
 // A Region represents a Linode deployment region.
 type Region struct {
-	ID      string `json:"id"`
-	Country string `json:"country"`
+	ID           string   `json:"id"`
+	Label        string   `json:"label"`
+	Country      string   `json:"country"`
+	Status       string   `json:"status"`
+	Capabilities []string `json:"capabilities"`
+}
+
+// HasCapability reports whether the Region lists a capability, such as "Block Storage" or one of
+// the RegionCapability constants.
+func (r Region) HasCapability(capability string) bool {
+	for _, c := range r.Capabilities {
+		if c == capability {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SupportsMetadata reports whether Linodes in the Region can be given cloud-init user data through
+// the metadata service.
+func (r Region) SupportsMetadata() bool {
+	return r.HasCapability(RegionCapabilityMetadata)
 }
 
 // A Regioner works with Linode regions.
@@ -0,0 +1,121 @@
+package lingo
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// A Resizer moves Linodes to a new type and sees the resize through until the Linode is back up.
+// Cold migrations shut the Linode down first, and any Linode that was running before the resize is
+// booted again afterwards if the API leaves it offline.
+type Resizer struct {
+	linodes Linoder
+	disks   Disker
+
+	// MigrationType is how the Linode is moved to its new host.
+	MigrationType MigrationType
+
+	// AllowAutoDiskResize grows the Linode's disk along with the type when the disk layout allows.
+	AllowAutoDiskResize bool
+
+	// PollingInterval is how often to check on the resize and the Linode's status.
+	PollingInterval time.Duration
+}
+
+// NewResizer returns a new Resizer given valid Linoder and Disker implementations.
+func NewResizer(linodes Linoder, disks Disker) Resizer {
+	return Resizer{
+		linodes:             linodes,
+		disks:               disks,
+		MigrationType:       MigrationTypeWarm,
+		AllowAutoDiskResize: true,
+		PollingInterval:     10 * time.Second,
+	}
+}
+
+// ValidateResize checks whether a Linode with the given disks can be resized to the target type.
+// The disks have to fit in the target type, so shrinking a Linode means shrinking its disks first.
+func ValidateResize(linode Linode, target LinodeType, disks []Disk) error {
+	if target.ID == linode.Type {
+		return errors.Errorf("Linode %s is already a %s", linode.Label, target.ID)
+	}
+
+	var used uint
+	for _, disk := range disks {
+		used += disk.Size
+	}
+
+	if target.Disk < 0 || used > uint(target.Disk) {
+		return errors.Errorf("Linode %s can't shrink from %d MB to %d MB of disk while its disks use %d MB; shrink them first", linode.Label, linode.Specs.Disk, target.Disk, used)
+	}
+
+	return nil
+}
+
+// Resize moves a Linode to a new type and waits until it's running again, calling progress, if
+// given, with the resize's Event each time it's checked.
+func (r Resizer) Resize(ctx context.Context, linodeID uint, typeID string, progress func(Event)) (Linode, error) {
+	linode, err := r.linodes.ViewLinode(linodeID)
+	if err != nil {
+		return linode, err
+	}
+
+	target, err := r.linodes.ViewType(typeID)
+	if err != nil {
+		return linode, err
+	}
+
+	disks, err := r.disks.ListDisks(linodeID)
+	if err != nil {
+		return linode, err
+	}
+
+	if err := ValidateResize(linode, target, disks); err != nil {
+		return linode, err
+	}
+
+	wasRunning := linode.Status == StatusRunning
+	if wasRunning && r.MigrationType == MigrationTypeCold {
+		if err := r.linodes.ShutdownLinode(linodeID); err != nil {
+			return linode, err
+		}
+
+		if linode, err = waitForLinodeStatus(ctx, r.linodes, linodeID, r.PollingInterval, StatusOffline); err != nil {
+			return linode, err
+		}
+	}
+
+	op, err := r.linodes.ResizeLinode(ResizeLinodeRequest{
+		ID:                  linodeID,
+		Type:                typeID,
+		AllowAutoDiskResize: r.AllowAutoDiskResize,
+		MigrationType:       r.MigrationType,
+	})
+	if err != nil {
+		return linode, err
+	}
+	op.PollingInterval = r.PollingInterval
+
+	if _, err := op.Wait(ctx, progress); err != nil {
+		return linode, err
+	}
+
+	if !wasRunning {
+		return r.linodes.ViewLinode(linodeID)
+	}
+
+	// The resize can still be wrapping up after its Event finishes, so wait for the Linode to
+	// settle before deciding whether it needs booting.
+	linode, err = waitForLinodeStatus(ctx, r.linodes, linodeID, r.PollingInterval, StatusRunning, StatusOffline)
+	if err != nil || linode.Status == StatusRunning {
+		return linode, err
+	}
+
+	if err := r.linodes.BootLinode(linodeID); err != nil {
+		return linode, err
+	}
+
+	return waitForLinodeStatus(ctx, r.linodes, linodeID, r.PollingInterval, StatusRunning)
+}
@@ -0,0 +1,121 @@
+package lingo_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/eriktate/lingo"
+)
+
+// fakeResizeLinodes plays out a resize on a single Linode. The API leaves the Linode offline after a
+// cold resize, so the Resizer has to boot it.
+type fakeResizeLinodes struct {
+	lingo.Linoder
+	mu     sync.Mutex
+	linode lingo.Linode
+	events *fakeEvents
+	calls  []string
+	req    lingo.ResizeLinodeRequest
+}
+
+func (f *fakeResizeLinodes) ViewLinode(id uint) (lingo.Linode, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.linode, nil
+}
+
+func (f *fakeResizeLinodes) ViewType(id string) (lingo.LinodeType, error) {
+	return lingo.LinodeType{ID: id, Disk: 51200}, nil
+}
+
+func (f *fakeResizeLinodes) ShutdownLinode(id uint) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, "shutdown")
+	f.linode.Status = lingo.StatusOffline
+	return nil
+}
+
+func (f *fakeResizeLinodes) BootLinode(id uint) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, "boot")
+	f.linode.Status = lingo.StatusRunning
+	return nil
+}
+
+func (f *fakeResizeLinodes) ResizeLinode(req lingo.ResizeLinodeRequest) (lingo.Operation, error) {
+	op, err := lingo.NewOperation(f.events, req.ID, lingo.EventActionLinodeResize)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, "resize")
+	f.req = req
+	f.linode.Type = req.Type
+	f.events.events = []lingo.Event{linodeEvent(1, req.ID, lingo.EventActionLinodeResize, lingo.EventStatusFinished, 100)}
+	return op, err
+}
+
+type fakeDisks struct {
+	lingo.Disker
+	disks []lingo.Disk
+}
+
+func (f fakeDisks) ListDisks(linodeID uint) ([]lingo.Disk, error) {
+	return f.disks, nil
+}
+
+func Test_ResizerResize(t *testing.T) {
+	linodes := &fakeResizeLinodes{
+		linode: lingo.Linode{ID: 1, Label: "app", Type: "g6-standard-1", Status: lingo.StatusRunning},
+		events: &fakeEvents{},
+	}
+	disks := fakeDisks{disks: []lingo.Disk{{Size: 25088}, {Size: 512}}}
+
+	r := lingo.NewResizer(linodes, disks)
+	r.MigrationType = lingo.MigrationTypeCold
+	r.PollingInterval = time.Millisecond
+
+	linode, err := r.Resize(context.Background(), 1, "g6-standard-2", nil)
+	if err != nil {
+		t.Fatalf("Failed to resize: %s", err)
+	}
+
+	if linode.Status != lingo.StatusRunning || linode.Type != "g6-standard-2" {
+		t.Fatalf("Expected a running g6-standard-2, but got %+v", linode)
+	}
+
+	expected := []string{"shutdown", "resize", "boot"}
+	if len(linodes.calls) != len(expected) {
+		t.Fatalf("Expected calls %v, but got %v", expected, linodes.calls)
+	}
+
+	for i := range expected {
+		if linodes.calls[i] != expected[i] {
+			t.Fatalf("Expected calls %v, but got %v", expected, linodes.calls)
+		}
+	}
+
+	if !linodes.req.AllowAutoDiskResize || linodes.req.MigrationType != lingo.MigrationTypeCold {
+		t.Fatalf("Expected a cold resize with auto disk resize, but got %+v", linodes.req)
+	}
+}
+
+func Test_ValidateResize(t *testing.T) {
+	linode := lingo.Linode{Label: "app", Type: "g6-standard-2", Specs: lingo.Specs{Disk: 81920}}
+	disks := []lingo.Disk{{Size: 60000}, {Size: 512}}
+
+	if err := lingo.ValidateResize(linode, lingo.LinodeType{ID: "g6-standard-2", Disk: 81920}, disks); err == nil {
+		t.Fatal("Expected resizing to the same type to be rejected")
+	}
+
+	if err := lingo.ValidateResize(linode, lingo.LinodeType{ID: "g6-standard-1", Disk: 51200}, disks); err == nil {
+		t.Fatal("Expected shrinking below the disk usage to be rejected")
+	}
+
+	if err := lingo.ValidateResize(linode, lingo.LinodeType{ID: "g6-standard-4", Disk: 163840}, disks); err != nil {
+		t.Fatalf("Expected growing to be allowed, but got %s", err)
+	}
+}
@@ -0,0 +1,62 @@
+package lingo
+
+import (
+	"context"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// defaultNameservers returns Linode's nameservers as host:port pairs.
+func defaultNameservers() []string {
+	nameservers := make([]string, len(linodeNameservers))
+	for i, ns := range linodeNameservers {
+		nameservers[i] = net.JoinHostPort(ns, "53")
+	}
+
+	return nameservers
+}
+
+// nameserverResolver returns a resolver that sends every query straight to a single nameserver,
+// bypassing the system resolver and any caching it does.
+func nameserverResolver(nameserver string) *net.Resolver {
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			var dialer net.Dialer
+			return dialer.DialContext(ctx, network, nameserver)
+		},
+	}
+}
+
+// waitForNameservers polls every nameserver each interval until the check passes against all of
+// them, or the context is done.
+func waitForNameservers(ctx context.Context, nameservers []string, interval time.Duration, check func(context.Context, *net.Resolver) bool) error {
+	pending := append([]string(nil), nameservers...)
+	for {
+		var remaining []string
+		for _, ns := range pending {
+			if !check(ctx, nameserverResolver(ns)) {
+				remaining = append(remaining, ns)
+			}
+		}
+
+		if len(remaining) == 0 {
+			return nil
+		}
+		pending = remaining
+
+		select {
+		case <-ctx.Done():
+			return errors.Errorf("timed out waiting on %s", strings.Join(pending, ", "))
+		case <-time.After(interval):
+		}
+	}
+}
+
+// absoluteName returns a name with a trailing dot so resolvers don't apply search domains to it.
+func absoluteName(name string) string {
+	return strings.TrimSuffix(name, ".") + "."
+}
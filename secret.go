@@ -0,0 +1,139 @@
+package lingo
+
+import (
+	"crypto/rand"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Password length limits for root passwords.
+const (
+	MinPasswordLength     = 11
+	MaxPasswordLength     = 128
+	DefaultPasswordLength = 32
+)
+
+// The character classes a generated password draws from. Symbols are limited to ones that don't
+// need quoting in a shell or escaping in a URL.
+const (
+	passwordLower   = "abcdefghijkmnopqrstuvwxyz"
+	passwordUpper   = "ABCDEFGHJKLMNPQRSTUVWXYZ"
+	passwordDigits  = "23456789"
+	passwordSymbols = "-_.,+=%@^"
+)
+
+// redacted is what a Secret prints as.
+const redacted = "[REDACTED]"
+
+// A Secret is a credential such as a root password. It marshals to its real value so it can be
+// sent to the API, but prints as [REDACTED] through fmt, log and slog so it doesn't end up in
+// logs by accident. Reveal returns the real value.
+type Secret string
+
+// Reveal returns the real value of the Secret.
+func (s Secret) Reveal() string {
+	return string(s)
+}
+
+// String implements the fmt.Stringer interface for Secrets. An empty Secret prints as empty so
+// it's clear when one wasn't set.
+func (s Secret) String() string {
+	if s == "" {
+		return ""
+	}
+
+	return redacted
+}
+
+// GoString implements the fmt.GoStringer interface for Secrets, covering the %#v verb.
+func (s Secret) GoString() string {
+	return fmt.Sprintf("lingo.Secret(%q)", s.String())
+}
+
+// Format implements the fmt.Formatter interface for Secrets, so every verb, including %q and %x,
+// prints the redacted form.
+func (s Secret) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if f.Flag('#') {
+			fmt.Fprint(f, s.GoString())
+			return
+		}
+		fmt.Fprint(f, s.String())
+	case 'q':
+		fmt.Fprintf(f, "%q", s.String())
+	default:
+		fmt.Fprint(f, s.String())
+	}
+}
+
+// LogValue implements the slog.LogValuer interface for Secrets.
+func (s Secret) LogValue() slog.Value {
+	return slog.StringValue(s.String())
+}
+
+// GeneratePassword generates a random password of the given length that meets Linode's password
+// rules. It always has at least one lowercase letter, uppercase letter, digit and symbol, and
+// leaves out characters that are easily confused, such as l, 1, O and 0.
+func GeneratePassword(length int) (Secret, error) {
+	if length < MinPasswordLength || length > MaxPasswordLength {
+		return "", errors.Errorf("password length must be between %d and %d, but got %d", MinPasswordLength, MaxPasswordLength, length)
+	}
+
+	classes := []string{passwordLower, passwordUpper, passwordDigits, passwordSymbols}
+	all := strings.Join(classes, "")
+
+	password := make([]byte, length)
+	for i := range password {
+		// The first characters cover each class, and are shuffled in below.
+		set := all
+		if i < len(classes) {
+			set = classes[i]
+		}
+
+		c, err := randomIndex(len(set))
+		if err != nil {
+			return "", err
+		}
+		password[i] = set[c]
+	}
+
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := randomIndex(i + 1)
+		if err != nil {
+			return "", err
+		}
+		password[i], password[j] = password[j], password[i]
+	}
+
+	return Secret(password), nil
+}
+
+func randomIndex(n int) (int, error) {
+	i, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to generate password")
+	}
+
+	return int(i.Int64()), nil
+}
+
+// generateRootPass fills in an empty root password when asked to, returning the generated password
+// or an empty Secret if none was generated.
+func generateRootPass(rootPass *Secret, generate bool) (Secret, error) {
+	if !generate || *rootPass != "" {
+		return "", nil
+	}
+
+	password, err := GeneratePassword(DefaultPasswordLength)
+	if err != nil {
+		return "", err
+	}
+
+	*rootPass = password
+	return password, nil
+}
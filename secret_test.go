@@ -0,0 +1,92 @@
+package lingo_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/eriktate/lingo"
+)
+
+func Test_SecretRedaction(t *testing.T) {
+	secret := lingo.Secret("hunter2-Hunter2")
+	req := lingo.CreateLinodeRequest{Region: "us-east", RootPass: secret}
+
+	for _, format := range []string{"%s", "%v", "%+v", "%#v", "%q", "%x", "%10s"} {
+		out := fmt.Sprintf(format, req)
+		if strings.Contains(out, "hunter2") {
+			t.Fatalf("Expected %s to redact the secret, but got %s", format, out)
+		}
+	}
+
+	if out := fmt.Sprintf("%v", secret); out != "[REDACTED]" {
+		t.Fatalf("Expected [REDACTED], but got %s", out)
+	}
+
+	var logs bytes.Buffer
+	slog.New(slog.NewJSONHandler(&logs, nil)).Info("creating", "root_pass", secret)
+	if strings.Contains(logs.String(), "hunter2") {
+		t.Fatalf("Expected slog to redact the secret, but got %s", logs.String())
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		t.Fatalf("Failed to marshal request: %s", err)
+	}
+
+	if !strings.Contains(string(payload), `"root_pass":"hunter2-Hunter2"`) {
+		t.Fatalf("Expected the real password to be marshalled, but got %s", payload)
+	}
+
+	if secret.Reveal() != "hunter2-Hunter2" {
+		t.Fatalf("Expected Reveal to return the real password, but got %s", secret.Reveal())
+	}
+}
+
+func Test_GeneratePassword(t *testing.T) {
+	seen := make(map[lingo.Secret]bool)
+	for i := 0; i < 100; i++ {
+		password, err := lingo.GeneratePassword(lingo.MinPasswordLength)
+		if err != nil {
+			t.Fatalf("Failed to generate password: %s", err)
+		}
+
+		value := password.Reveal()
+		if len(value) != lingo.MinPasswordLength {
+			t.Fatalf("Expected a %d character password, but got %q", lingo.MinPasswordLength, value)
+		}
+
+		var lower, upper, digit, symbol bool
+		for _, r := range value {
+			switch {
+			case unicode.IsLower(r):
+				lower = true
+			case unicode.IsUpper(r):
+				upper = true
+			case unicode.IsDigit(r):
+				digit = true
+			default:
+				symbol = true
+			}
+		}
+
+		if !lower || !upper || !digit || !symbol {
+			t.Fatalf("Expected every character class in %q", value)
+		}
+
+		if seen[password] {
+			t.Fatalf("Generated %q twice", value)
+		}
+		seen[password] = true
+	}
+
+	for _, length := range []int{lingo.MinPasswordLength - 1, lingo.MaxPasswordLength + 1} {
+		if _, err := lingo.GeneratePassword(length); err == nil {
+			t.Fatalf("Expected a length of %d to be rejected", length)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package lingo
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+const (
+	sshDefaultPort     = 22
+	defaultScanTimeout = 10 * time.Second
+)
+
+// errHostKeyCaptured stops a handshake once the host key is in hand, since there's nothing to log
+// in with.
+var errHostKeyCaptured = errors.New("host key captured")
+
+// An SSHKey is an ed25519 key pair for logging in to Linodes. Its public half goes in the
+// AuthorizedKeys of create and rebuild requests.
+type SSHKey struct {
+	private ed25519.PrivateKey
+
+	// Comment is appended to the authorized key and stored in the private key, usually user@host.
+	Comment string
+}
+
+// GenerateSSHKey generates a new ed25519 SSHKey.
+func GenerateSSHKey(comment string) (SSHKey, error) {
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return SSHKey{}, errors.Wrap(err, "failed to generate SSH key")
+	}
+
+	return SSHKey{private: private, Comment: comment}, nil
+}
+
+// ParseSSHKey loads an ed25519 SSHKey from a PEM encoded private key, either in OpenSSH's own
+// format, as written by ssh-keygen and MarshalPrivateKey, or as PKCS #8. Encrypted keys aren't
+// supported, and the key's comment isn't loaded.
+func ParseSSHKey(data []byte) (SSHKey, error) {
+	key, err := ssh.ParseRawPrivateKey(data)
+	if err != nil {
+		return SSHKey{}, errors.Wrap(err, "failed to parse SSH key")
+	}
+
+	switch private := key.(type) {
+	case ed25519.PrivateKey:
+		return SSHKey{private: private}, nil
+	case *ed25519.PrivateKey:
+		return SSHKey{private: *private}, nil
+	default:
+		return SSHKey{}, errors.Errorf("only ed25519 SSH keys are supported, but got %T", key)
+	}
+}
+
+// PublicKey returns the public half of the SSHKey.
+func (k SSHKey) PublicKey() ed25519.PublicKey {
+	if k.private == nil {
+		return nil
+	}
+
+	return k.private.Public().(ed25519.PublicKey)
+}
+
+// AuthorizedKey returns the public key as a line for authorized_keys, which is also the form the
+// API takes in AuthorizedKeys.
+func (k SSHKey) AuthorizedKey() string {
+	public, err := ssh.NewPublicKey(k.PublicKey())
+	if err != nil {
+		return ""
+	}
+
+	key := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(public)))
+	if k.Comment != "" {
+		key += " " + k.Comment
+	}
+
+	return key
+}
+
+// MarshalPrivateKey returns the private key PEM encoded in OpenSSH's format, ready to be written
+// out as an identity file for ssh. The key isn't encrypted, so the file should only be readable by
+// its owner.
+func (k SSHKey) MarshalPrivateKey() ([]byte, error) {
+	if k.private == nil {
+		return nil, errors.New("SSH key has no private key")
+	}
+
+	block, err := ssh.MarshalPrivateKey(k.private, k.Comment)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal SSH key")
+	}
+
+	return pem.EncodeToMemory(block), nil
+}
+
+// A KnownHost is a host key captured from an SSH server.
+type KnownHost struct {
+	// Address is the host:port the key was captured from.
+	Address string
+
+	// Key is the server's host key.
+	Key ssh.PublicKey
+}
+
+// String returns the KnownHost as a line for a known_hosts file.
+func (h KnownHost) String() string {
+	if h.Key == nil {
+		return ""
+	}
+
+	return knownhosts.Line([]string{h.Address}, h.Key)
+}
+
+// Fingerprint returns the host key's SHA256 fingerprint in the form ssh-keygen -l prints.
+func (h KnownHost) Fingerprint() string {
+	if h.Key == nil {
+		return ""
+	}
+
+	return ssh.FingerprintSHA256(h.Key)
+}
+
+// ScanHostKey connects to the SSH server at address, given as host:port, and runs the key exchange
+// to capture its host key. The server has to prove it holds the host key by signing the exchange,
+// so a KnownHost is only returned once the server has completed a handshake. Nothing is
+// authenticated and the connection is closed straight after.
+func ScanHostKey(ctx context.Context, address string) (KnownHost, error) {
+	known := KnownHost{Address: address}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return known, errors.Wrapf(err, "failed to connect to SSH at %s", address)
+	}
+	defer conn.Close()
+
+	// A server that's still booting can accept connections and then stall, so each scan gets its
+	// own timeout on top of the context's.
+	deadline := time.Now().Add(defaultScanTimeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	conn.SetDeadline(deadline)
+
+	config := &ssh.ClientConfig{
+		User: "root",
+		HostKeyCallback: func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+			known.Key = key
+			return errHostKeyCaptured
+		},
+	}
+
+	client, _, _, err := ssh.NewClientConn(conn, address, config)
+	if err == nil {
+		client.Close()
+	}
+
+	if known.Key == nil {
+		return known, errors.Wrapf(err, "SSH handshake with %s failed", address)
+	}
+
+	return known, nil
+}
+
+// withAuthorizedKey adds a key to a list of authorized keys unless it's already there, comparing
+// keys without their comments.
+func withAuthorizedKey(keys []string, key string) []string {
+	for _, k := range keys {
+		if authorizedKeyData(k) == authorizedKeyData(key) {
+			return keys
+		}
+	}
+
+	return append(append([]string(nil), keys...), key)
+}
+
+func authorizedKeyData(key string) string {
+	fields := strings.Fields(key)
+	if len(fields) < 2 {
+		return key
+	}
+
+	return fields[0] + " " + fields[1]
+}
@@ -0,0 +1,90 @@
+package lingo
+
+// A TicketStatus is an enumeration of possible support ticket statuses.
+type TicketStatus string
+
+// Enum values for TicketStatus.
+const (
+	TicketStatusNew    = TicketStatus("new")
+	TicketStatusOpen   = TicketStatus("open")
+	TicketStatusClosed = TicketStatus("closed")
+)
+
+// A TicketEntity describes the resource a support ticket was opened against.
+type TicketEntity struct {
+	ID    uint   `json:"id"`
+	Label string `json:"label"`
+	Type  string `json:"type"`
+	URL   string `json:"url"`
+}
+
+// A SupportTicket represents a Linode support ticket.
+type SupportTicket struct {
+	ID          uint          `json:"id"`
+	Summary     string        `json:"summary"`
+	Description string        `json:"description"`
+	Status      TicketStatus  `json:"status"`
+	Entity      *TicketEntity `json:"entity"`
+	Attachments []string      `json:"attachments"`
+	Closable    bool          `json:"closable"`
+	OpenedBy    string        `json:"opened_by"`
+	UpdatedBy   string        `json:"updated_by"`
+	Opened      Time          `json:"opened"`
+	Updated     Time          `json:"updated"`
+	Closed      *Time         `json:"closed"`
+}
+
+// A TicketAttachment is a file to be attached to a support ticket.
+type TicketAttachment struct {
+	Name string
+	Data []byte
+}
+
+// An OpenTicketRequest is a parameter struct for opening a new support ticket. At most one of the
+// entity IDs should be set to tie the ticket to a particular resource. Any Attachments are uploaded
+// once the ticket has been opened.
+type OpenTicketRequest struct {
+	Summary        string             `json:"summary"`
+	Description    string             `json:"description"`
+	LinodeID       uint               `json:"linode_id,omitempty"`
+	VolumeID       uint               `json:"volume_id,omitempty"`
+	DomainID       uint               `json:"domain_id,omitempty"`
+	NodeBalancerID uint               `json:"nodebalancer_id,omitempty"`
+	Attachments    []TicketAttachment `json:"-"`
+}
+
+// A TicketReply represents a single reply on a support ticket.
+type TicketReply struct {
+	ID          uint   `json:"id"`
+	Description string `json:"description"`
+	CreatedBy   string `json:"created_by"`
+	FromLinode  bool   `json:"from_linode"`
+	Created     Time   `json:"created"`
+}
+
+// A TicketReplyRequest is a parameter struct for replying to an existing support ticket.
+type TicketReplyRequest struct {
+	TicketID    uint   `json:"-"`
+	Description string `json:"description"`
+}
+
+// A Supporter works with Linode support tickets.
+type Supporter interface {
+	ListTickets() ([]SupportTicket, error)
+	ViewTicket(id uint) (SupportTicket, error)
+	OpenTicket(req OpenTicketRequest) (SupportTicket, error)
+	CloseTicket(id uint) error
+	ListTicketReplies(id uint) ([]TicketReply, error)
+	ReplyToTicket(req TicketReplyRequest) (TicketReply, error)
+	UploadTicketAttachment(id uint, attachment TicketAttachment) error
+}
+
+// ValidateTicketStatus validates whether or not a test string is a TicketStatus enum.
+func ValidateTicketStatus(test string) bool {
+	switch TicketStatus(test) {
+	case TicketStatusNew, TicketStatusOpen, TicketStatusClosed:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,163 @@
+package lingo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+
+	"github.com/pkg/errors"
+)
+
+// A SupportClient implements the Supporter interface and provides all of the functionality for
+// managing support tickets in a Linode account.
+type SupportClient struct {
+	api APIClient
+}
+
+// NewSupportClient returns a new SupportClient given a valid APIClient.
+func NewSupportClient(api APIClient) SupportClient {
+	return SupportClient{api: api}
+}
+
+// ListTickets retrieves a slice of the support tickets in a Linode account.
+func (c SupportClient) ListTickets() ([]SupportTicket, error) {
+	data, err := c.api.Get("support/tickets")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make request for ListTickets")
+	}
+
+	var results Results
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, errors.Wrap(err, "failed to decode ListTickets response")
+	}
+
+	var tickets []SupportTicket
+	if err := json.Unmarshal(results.Data, &tickets); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal ListTickets data")
+	}
+
+	return tickets, nil
+}
+
+// ViewTicket retrieves a specific support ticket.
+func (c SupportClient) ViewTicket(id uint) (SupportTicket, error) {
+	var ticket SupportTicket
+
+	data, err := c.api.Get(fmt.Sprintf("support/tickets/%d", id))
+	if err != nil {
+		return ticket, errors.Wrap(err, "failed to make request for ViewTicket")
+	}
+
+	if err := json.Unmarshal(data, &ticket); err != nil {
+		return ticket, errors.Wrap(err, "failed to unmarshal ViewTicket data")
+	}
+
+	return ticket, nil
+}
+
+// OpenTicket opens a new support ticket and uploads any attachments in the request. If an
+// attachment fails to upload the ticket that was opened is still returned along with the error.
+func (c SupportClient) OpenTicket(req OpenTicketRequest) (SupportTicket, error) {
+	var ticket SupportTicket
+
+	payload, err := json.Marshal(&req)
+	if err != nil {
+		return ticket, errors.Wrap(err, "failed to marshal request for OpenTicket")
+	}
+
+	data, err := c.api.Post("support/tickets", payload)
+	if err != nil {
+		return ticket, errors.Wrap(err, "failed to make request for OpenTicket")
+	}
+
+	if err := json.Unmarshal(data, &ticket); err != nil {
+		return ticket, errors.Wrap(err, "failed to decode OpenTicket response")
+	}
+
+	for _, attachment := range req.Attachments {
+		if err := c.UploadTicketAttachment(ticket.ID, attachment); err != nil {
+			return ticket, err
+		}
+
+		ticket.Attachments = append(ticket.Attachments, attachment.Name)
+	}
+
+	return ticket, nil
+}
+
+// CloseTicket closes a support ticket. Only tickets marked as closable can be closed.
+func (c SupportClient) CloseTicket(id uint) error {
+	if _, err := c.api.Post(fmt.Sprintf("support/tickets/%d/close", id), nil); err != nil {
+		return errors.Wrap(err, "failed to make request for CloseTicket")
+	}
+
+	return nil
+}
+
+// ListTicketReplies retrieves a slice of the replies on a support ticket.
+func (c SupportClient) ListTicketReplies(id uint) ([]TicketReply, error) {
+	data, err := c.api.Get(fmt.Sprintf("support/tickets/%d/replies", id))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make request for ListTicketReplies")
+	}
+
+	var results Results
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, errors.Wrap(err, "failed to decode ListTicketReplies response")
+	}
+
+	var replies []TicketReply
+	if err := json.Unmarshal(results.Data, &replies); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal ListTicketReplies data")
+	}
+
+	return replies, nil
+}
+
+// ReplyToTicket adds a reply to an existing support ticket.
+func (c SupportClient) ReplyToTicket(req TicketReplyRequest) (TicketReply, error) {
+	var reply TicketReply
+
+	payload, err := json.Marshal(&req)
+	if err != nil {
+		return reply, errors.Wrap(err, "failed to marshal request for ReplyToTicket")
+	}
+
+	data, err := c.api.Post(fmt.Sprintf("support/tickets/%d/replies", req.TicketID), payload)
+	if err != nil {
+		return reply, errors.Wrap(err, "failed to make request for ReplyToTicket")
+	}
+
+	if err := json.Unmarshal(data, &reply); err != nil {
+		return reply, errors.Wrap(err, "failed to decode ReplyToTicket response")
+	}
+
+	return reply, nil
+}
+
+// UploadTicketAttachment uploads a file and attaches it to an existing support ticket.
+func (c SupportClient) UploadTicketAttachment(id uint, attachment TicketAttachment) error {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	part, err := writer.CreateFormFile("file", attachment.Name)
+	if err != nil {
+		return errors.Wrap(err, "failed to build request for UploadTicketAttachment")
+	}
+
+	if _, err := part.Write(attachment.Data); err != nil {
+		return errors.Wrap(err, "failed to build request for UploadTicketAttachment")
+	}
+
+	if err := writer.Close(); err != nil {
+		return errors.Wrap(err, "failed to build request for UploadTicketAttachment")
+	}
+
+	path := fmt.Sprintf("support/tickets/%d/attachments", id)
+	if _, err := c.api.PostMultipart(path, writer.FormDataContentType(), body.Bytes()); err != nil {
+		return errors.Wrapf(err, "failed to make request for UploadTicketAttachment %s", attachment.Name)
+	}
+
+	return nil
+}
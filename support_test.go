@@ -0,0 +1,104 @@
+package lingo_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/eriktate/lingo"
+)
+
+// Opening tickets would page real people at Linode, so only the read side of the support API is
+// exercised here.
+func Test_SupportTickets(t *testing.T) {
+	apiKey := os.Getenv("LINODE_API_KEY")
+	api := lingo.NewAPIClient(apiKey, nil)
+	client := lingo.NewSupportClient(api)
+
+	tickets, err := client.ListTickets()
+	if err != nil {
+		t.Fatalf("Failed to list tickets: %s", err)
+	}
+
+	if len(tickets) == 0 {
+		t.Skip("No tickets on this account to inspect")
+	}
+
+	ticket, err := client.ViewTicket(tickets[0].ID)
+	if err != nil {
+		t.Fatalf("Failed to view ticket: %s", err)
+	}
+
+	if ticket.Summary != tickets[0].Summary {
+		t.Fatal("Ticket doesn't match Tickets slice")
+	}
+
+	if !lingo.ValidateTicketStatus(string(ticket.Status)) {
+		t.Fatalf("Unexpected ticket status: %s", ticket.Status)
+	}
+
+	if _, err := client.ListTicketReplies(ticket.ID); err != nil {
+		t.Fatalf("Failed to list ticket replies: %s", err)
+	}
+}
+
+func Test_UploadTicketAttachment(t *testing.T) {
+	var (
+		filename string
+		data     []byte
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/support/tickets/42/attachments" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		if r.Header.Get("Authorization") != "Bearer test-key" {
+			t.Errorf("Unexpected Authorization header %q", r.Header.Get("Authorization"))
+		}
+
+		mediaType, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if err != nil || mediaType != "multipart/form-data" {
+			t.Errorf("Expected multipart/form-data, but got %q", r.Header.Get("Content-Type"))
+			return
+		}
+
+		reader := multipart.NewReader(r.Body, params["boundary"])
+		for {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				break
+			}
+
+			if err != nil {
+				t.Errorf("Failed to read part: %s", err)
+				return
+			}
+
+			if part.FormName() == "file" {
+				filename = part.FileName()
+				data, _ = io.ReadAll(part)
+			}
+		}
+
+		fmt.Fprint(w, "{}")
+	}))
+	defer server.Close()
+
+	client := lingo.NewSupportClient(lingo.NewAPIClientWithURI("test-key", server.URL+"/"))
+	attachment := lingo.TicketAttachment{Name: "dmesg.log", Data: []byte("kernel: oops\x00\xff")}
+
+	if err := client.UploadTicketAttachment(42, attachment); err != nil {
+		t.Fatalf("Failed to upload attachment: %s", err)
+	}
+
+	if filename != attachment.Name || !bytes.Equal(data, attachment.Data) {
+		t.Fatalf("Expected file %s with %q, but got %s with %q", attachment.Name, attachment.Data, filename, data)
+	}
+}
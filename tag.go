@@ -0,0 +1,131 @@
+package lingo
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// A TaggedObjectType is an enumeration of the kinds of resources that can carry a tag.
+type TaggedObjectType string
+
+// Enum values for TaggedObjectType.
+const (
+	TaggedObjectLinode       = TaggedObjectType("linode")
+	TaggedObjectDomain       = TaggedObjectType("domain")
+	TaggedObjectVolume       = TaggedObjectType("volume")
+	TaggedObjectNodeBalancer = TaggedObjectType("nodebalancer")
+	TaggedObjectLKECluster   = TaggedObjectType("lke_cluster")
+)
+
+// A Tag represents a Linode tag.
+type Tag struct {
+	Label string `json:"label"`
+}
+
+// A CreateTagRequest is a parameter struct for creating a new tag and optionally applying it to
+// existing resources.
+type CreateTagRequest struct {
+	Label         string `json:"label"`
+	Linodes       []uint `json:"linodes,omitempty"`
+	Domains       []uint `json:"domains,omitempty"`
+	Volumes       []uint `json:"volumes,omitempty"`
+	NodeBalancers []uint `json:"nodebalancers,omitempty"`
+}
+
+// A TaggedObject is a single resource carrying a tag. The Data is left raw since its shape depends
+// on the Type; use the typed accessors to decode it.
+type TaggedObject struct {
+	Type TaggedObjectType `json:"type"`
+	Data json.RawMessage  `json:"data"`
+}
+
+// Linode decodes the TaggedObject as a Linode instance.
+func (o TaggedObject) Linode() (Linode, error) {
+	var linode Linode
+	if err := o.decode(TaggedObjectLinode, &linode); err != nil {
+		return linode, err
+	}
+
+	return linode, nil
+}
+
+// Domain decodes the TaggedObject as a Domain.
+func (o TaggedObject) Domain() (Domain, error) {
+	var domain Domain
+	if err := o.decode(TaggedObjectDomain, &domain); err != nil {
+		return domain, err
+	}
+
+	return domain, nil
+}
+
+// Volume decodes the TaggedObject as a Volume.
+func (o TaggedObject) Volume() (Volume, error) {
+	var volume Volume
+	if err := o.decode(TaggedObjectVolume, &volume); err != nil {
+		return volume, err
+	}
+
+	return volume, nil
+}
+
+// NodeBalancer decodes the TaggedObject as a NodeBalancer.
+func (o TaggedObject) NodeBalancer() (NodeBalancer, error) {
+	var balancer NodeBalancer
+	if err := o.decode(TaggedObjectNodeBalancer, &balancer); err != nil {
+		return balancer, err
+	}
+
+	return balancer, nil
+}
+
+// LKECluster decodes the TaggedObject as an LKE cluster.
+func (o TaggedObject) LKECluster() (LKECluster, error) {
+	var cluster LKECluster
+	if err := o.decode(TaggedObjectLKECluster, &cluster); err != nil {
+		return cluster, err
+	}
+
+	return cluster, nil
+}
+
+func (o TaggedObject) decode(expected TaggedObjectType, v interface{}) error {
+	if o.Type != expected {
+		return errors.Errorf("tagged object is a %s, not a %s", o.Type, expected)
+	}
+
+	if err := json.Unmarshal(o.Data, v); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal tagged %s", o.Type)
+	}
+
+	return nil
+}
+
+// TagList returns tags in the form update requests take them. Tags are only changed by an update
+// when they're set, so TagList() with no tags clears them.
+func TagList(tags ...string) *[]string {
+	if tags == nil {
+		tags = []string{}
+	}
+
+	return &tags
+}
+
+// A Tagger works with Linode tags.
+type Tagger interface {
+	ListTags() ([]Tag, error)
+	CreateTag(req CreateTagRequest) (Tag, error)
+	DeleteTag(label string) error
+	ListTaggedObjects(label string) ([]TaggedObject, error)
+}
+
+// ValidateTaggedObjectType validates whether or not a test string is a TaggedObjectType enum.
+func ValidateTaggedObjectType(test string) bool {
+	switch TaggedObjectType(test) {
+	case TaggedObjectLinode, TaggedObjectDomain, TaggedObjectVolume, TaggedObjectNodeBalancer, TaggedObjectLKECluster:
+		return true
+	default:
+		return false
+	}
+}
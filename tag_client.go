@@ -0,0 +1,89 @@
+package lingo
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+// A TagClient implements the Tagger interface and provides all of the functionality for managing
+// tags in a Linode account.
+type TagClient struct {
+	api APIClient
+}
+
+// NewTagClient returns a new TagClient given a valid APIClient.
+func NewTagClient(api APIClient) TagClient {
+	return TagClient{api: api}
+}
+
+// ListTags retrieves a slice of every tag in a Linode account.
+func (c TagClient) ListTags() ([]Tag, error) {
+	data, err := c.api.Get("tags")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make request for ListTags")
+	}
+
+	var results Results
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, errors.Wrap(err, "failed to decode ListTags response")
+	}
+
+	var tags []Tag
+	if err := json.Unmarshal(results.Data, &tags); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal ListTags data")
+	}
+
+	return tags, nil
+}
+
+// CreateTag creates a new tag and applies it to any resources listed in the request.
+func (c TagClient) CreateTag(req CreateTagRequest) (Tag, error) {
+	var tag Tag
+
+	payload, err := json.Marshal(&req)
+	if err != nil {
+		return tag, errors.Wrap(err, "failed to marshal request for CreateTag")
+	}
+
+	data, err := c.api.Post("tags", payload)
+	if err != nil {
+		return tag, errors.Wrap(err, "failed to make request for CreateTag")
+	}
+
+	if err := json.Unmarshal(data, &tag); err != nil {
+		return tag, errors.Wrap(err, "failed to decode CreateTag response")
+	}
+
+	return tag, nil
+}
+
+// DeleteTag deletes a tag and removes it from every resource carrying it.
+func (c TagClient) DeleteTag(label string) error {
+	if _, err := c.api.Delete("tags/" + url.PathEscape(label)); err != nil {
+		return errors.Wrap(err, "failed to make request for DeleteTag")
+	}
+
+	return nil
+}
+
+// ListTaggedObjects retrieves a slice of every resource, of any type, that carries the given tag.
+func (c TagClient) ListTaggedObjects(label string) ([]TaggedObject, error) {
+	data, err := c.api.Get("tags/" + url.PathEscape(label))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make request for ListTaggedObjects")
+	}
+
+	var results Results
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, errors.Wrap(err, "failed to decode ListTaggedObjects response")
+	}
+
+	var objects []TaggedObject
+	if err := json.Unmarshal(results.Data, &objects); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal ListTaggedObjects data")
+	}
+
+	return objects, nil
+}
@@ -0,0 +1,177 @@
+package lingo_test
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/eriktate/lingo"
+)
+
+func Test_CRUDTag(t *testing.T) {
+	apiKey := os.Getenv("LINODE_API_KEY")
+	api := lingo.NewAPIClient(apiKey, nil)
+	client := lingo.NewTagClient(api)
+	linodeClient := lingo.NewLinodeClient(api)
+	domainClient := lingo.NewDomainClient(api)
+
+	createLinode := lingo.CreateLinodeRequest{
+		Region:           "us-east-1a",
+		Type:             "g5-nanode-1",
+		Image:            "linode/debian9",
+		GenerateRootPass: true,
+		Tags:             []string{"lingo-team-a"},
+	}
+
+	testLinode, err := linodeClient.CreateLinode(createLinode)
+	if err != nil {
+		t.Fatalf("Failed to create linode: %s", err)
+	}
+
+	newDomain := lingo.Domain{
+		Domain: "testdomain.io",
+		Type:   lingo.DomainTypeMaster,
+		SOA:    "test@otherdomain.com",
+	}
+
+	domain, err := domainClient.CreateDomain(newDomain)
+	if err != nil {
+		t.Fatalf("Failed to create domain: %s", err)
+	}
+
+	createTag := lingo.CreateTagRequest{
+		Label:   "lingo-env-test",
+		Linodes: []uint{testLinode.ID},
+		Domains: []uint{domain.ID},
+	}
+
+	if _, err := client.CreateTag(createTag); err != nil {
+		t.Fatalf("Failed to create tag: %s", err)
+	}
+
+	tags, err := client.ListTags()
+	if err != nil {
+		t.Fatalf("Failed to list tags: %s", err)
+	}
+
+	if len(tags) < 2 {
+		t.Fatalf("Expected at least 2 tags, but got %d", len(tags))
+	}
+
+	objects, err := client.ListTaggedObjects(createTag.Label)
+	if err != nil {
+		t.Fatalf("Failed to list tagged objects: %s", err)
+	}
+
+	if len(objects) != 2 {
+		t.Fatalf("Something strange happened. Expected 2 tagged objects, but got %d", len(objects))
+	}
+
+	linodes, err := linodeClient.ListLinodesByTag("lingo-team-a")
+	if err != nil {
+		t.Fatalf("Failed to list linodes by tag: %s", err)
+	}
+
+	if len(linodes) != 1 || linodes[0].ID != testLinode.ID {
+		t.Fatal("Tag filter didn't return the tagged linode")
+	}
+
+	if err := client.DeleteTag(createTag.Label); err != nil {
+		t.Fatalf("Failed to delete tag: %s", err)
+	}
+
+	if err := client.DeleteTag("lingo-team-a"); err != nil {
+		t.Fatalf("Failed to delete tag: %s", err)
+	}
+
+	if err := domainClient.DeleteDomain(domain.ID); err != nil {
+		t.Fatalf("Failed to cleanup domain: %s", err)
+	}
+
+	if err := linodeClient.DeleteLinode(testLinode.ID); err != nil {
+		t.Fatalf("Failed to cleanup linode: %s", err)
+	}
+}
+
+func Test_TaggedObjectDecode(t *testing.T) {
+	raw := []byte(`[
+		{"type": "linode", "data": {"id": 123, "label": "web-1", "tags": ["prod"]}},
+		{"type": "volume", "data": {"id": 456, "label": "data-1", "tags": ["prod"]}}
+	]`)
+
+	var objects []lingo.TaggedObject
+	if err := json.Unmarshal(raw, &objects); err != nil {
+		t.Fatalf("Failed to unmarshal tagged objects: %s", err)
+	}
+
+	linode, err := objects[0].Linode()
+	if err != nil {
+		t.Fatalf("Failed to decode tagged linode: %s", err)
+	}
+
+	if linode.ID != 123 || len(linode.Tags) != 1 || linode.Tags[0] != "prod" {
+		t.Fatalf("Decoded linode doesn't match: %+v", linode)
+	}
+
+	if _, err := objects[1].Linode(); err == nil {
+		t.Fatal("Expected decoding a volume as a linode to fail")
+	}
+
+	volume, err := objects[1].Volume()
+	if err != nil {
+		t.Fatalf("Failed to decode tagged volume: %s", err)
+	}
+
+	if volume.ID != 456 {
+		t.Fatalf("Decoded volume doesn't match: %+v", volume)
+	}
+}
+
+func Test_UpdateRequestTags(t *testing.T) {
+	requests := map[string]interface{}{
+		"linode":       lingo.UpdateLinodeRequest{ID: 1, Tags: lingo.TagList()},
+		"volume":       lingo.UpdateVolumeRequest{ID: 1, Tags: lingo.TagList()},
+		"nodebalancer": lingo.UpdateBalancerRequest{ID: 1, Tags: lingo.TagList()},
+		"lke":          lingo.UpdateLKEClusterRequest{ID: 1, Tags: lingo.TagList()},
+		"domain":       lingo.UpdateDomainRequest{ID: 1, Tags: lingo.TagList()},
+	}
+
+	for name, req := range requests {
+		data, err := json.Marshal(req)
+		if err != nil {
+			t.Fatalf("Failed to marshal %s request: %s", name, err)
+		}
+
+		if !strings.Contains(string(data), `"tags":[]`) {
+			t.Fatalf("Expected clearing %s tags to send an empty list, but got %s", name, data)
+		}
+	}
+
+	data, err := json.Marshal(lingo.UpdateLinodeRequest{ID: 1, Label: "web-1"})
+	if err != nil {
+		t.Fatalf("Failed to marshal linode request: %s", err)
+	}
+
+	if strings.Contains(string(data), "tags") {
+		t.Fatalf("Expected tags to be left out when not set, but got %s", data)
+	}
+
+	data, err = json.Marshal(lingo.UpdateDomainRequest{ID: 1, SOA: "admin@example.com"})
+	if err != nil {
+		t.Fatalf("Failed to marshal domain request: %s", err)
+	}
+
+	if strings.Contains(string(data), "tags") {
+		t.Fatalf("Expected domain tags to be left out when not set, but got %s", data)
+	}
+
+	data, err = json.Marshal(lingo.UpdateLinodeRequest{ID: 1, Tags: lingo.TagList("prod")})
+	if err != nil {
+		t.Fatalf("Failed to marshal linode request: %s", err)
+	}
+
+	if !strings.Contains(string(data), `"tags":["prod"]`) {
+		t.Fatalf("Expected tags to be sent, but got %s", data)
+	}
+}
@@ -25,21 +25,25 @@ type Volume struct {
 	Updated        Time         `json:"updated"`
 	LinodeID       uint         `json:"linode_id"`
 	FilesystemPath string       `json:"filesystem_path"`
+	Tags           []string     `json:"tags"`
 }
 
 // A CreateVolumeRequest is a parameter struct for specifying a new volume.
 type CreateVolumeRequest struct {
-	Label    string `json:"label"`
-	Size     uint   `json:"size"`
-	Region   string `json:"region,omitempty"`
-	LinodeID uint   `json:"linode_id,omitempty"`
-	ConfigID uint   `json:"config_id,omitempty"`
+	Label    string   `json:"label"`
+	Size     uint     `json:"size"`
+	Region   string   `json:"region,omitempty"`
+	LinodeID uint     `json:"linode_id,omitempty"`
+	ConfigID uint     `json:"config_id,omitempty"`
+	Tags     []string `json:"tags,omitempty"`
 }
 
 // An UpdateVolumeRequest is a parameter struct for specifying how an existing volume should be updated.
+// Tags are left alone unless set, and TagList() with no tags clears them.
 type UpdateVolumeRequest struct {
-	ID    uint   `json:"-"`
-	Label string `json:"label"`
+	ID    uint      `json:"-"`
+	Label string    `json:"label"`
+	Tags  *[]string `json:"tags,omitempty"`
 }
 
 // An AttachVolumeRequest is a paremeter struct for specifying how an existing volume should be attached
@@ -53,6 +57,7 @@ type AttachVolumeRequest struct {
 // A Volumer works with Linode volumes.
 type Volumer interface {
 	ListVolumes() ([]Volume, error)
+	ListVolumesByTag(tag string) ([]Volume, error)
 	ViewVolume(id uint) (Volume, error)
 	CreateVolume(req CreateVolumeRequest) (Volume, error)
 	UpdateVolume(req UpdateVolumeRequest) (Volume, error)
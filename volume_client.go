@@ -35,6 +35,26 @@ func (c VolumeClient) ListVolumes() ([]Volume, error) {
 	return volumes, nil
 }
 
+// ListVolumesByTag retrieves a slice of the volumes carrying the given tag.
+func (c VolumeClient) ListVolumesByTag(tag string) ([]Volume, error) {
+	data, err := c.api.GetFiltered("volumes", TagFilter(tag))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make request for ListVolumesByTag")
+	}
+
+	var results Results
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, errors.Wrap(err, "failed to decode ListVolumesByTag response")
+	}
+
+	var volumes []Volume
+	if err := json.Unmarshal(results.Data, &volumes); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal ListVolumesByTag data")
+	}
+
+	return volumes, nil
+}
+
 // ViewVolume retrieves a slice of machine volume available in Linode.
 func (c VolumeClient) ViewVolume(id uint) (Volume, error) {
 	var volume Volume
@@ -0,0 +1,148 @@
+package lingo
+
+import (
+	"net/netip"
+
+	"github.com/pkg/errors"
+)
+
+// A VPC represents an isolated private network that Linode instances can join through a VPC
+// configuration interface.
+type VPC struct {
+	ID          uint        `json:"id"`
+	Label       string      `json:"label"`
+	Description string      `json:"description"`
+	Region      string      `json:"region"`
+	Subnets     []VPCSubnet `json:"subnets"`
+	Created     Time        `json:"created"`
+	Updated     Time        `json:"updated"`
+}
+
+// A VPCSubnet represents an IPv4 subnet within a VPC.
+type VPCSubnet struct {
+	ID      uint              `json:"id"`
+	Label   string            `json:"label"`
+	IPv4    string            `json:"ipv4"`
+	Linodes []VPCSubnetLinode `json:"linodes"`
+	Created Time              `json:"created"`
+	Updated Time              `json:"updated"`
+}
+
+// A VPCSubnetLinode is a Linode instance attached to a VPC subnet, along with the configuration
+// interfaces attaching it.
+type VPCSubnetLinode struct {
+	ID         uint                 `json:"id"`
+	Interfaces []VPCSubnetInterface `json:"interfaces"`
+}
+
+// A VPCSubnetInterface is a configuration interface attaching a Linode instance to a VPC subnet.
+// An interface is only active while the Linode is running the config it belongs to.
+type VPCSubnetInterface struct {
+	ID     uint `json:"id"`
+	Active bool `json:"active"`
+}
+
+// A VPCAddress is an address a Linode instance holds within a VPC.
+type VPCAddress struct {
+	Address      string `json:"address"`
+	AddressRange string `json:"address_range"`
+	Gateway      string `json:"gateway"`
+	Prefix       uint   `json:"prefix"`
+	SubnetMask   string `json:"subnet_mask"`
+	NAT1To1      string `json:"nat_1_1"`
+	VPCID        uint   `json:"vpc_id"`
+	SubnetID     uint   `json:"subnet_id"`
+	LinodeID     uint   `json:"linode_id"`
+	ConfigID     uint   `json:"config_id"`
+	InterfaceID  uint   `json:"interface_id"`
+	Region       string `json:"region"`
+	Active       bool   `json:"active"`
+}
+
+// A VLAN represents a layer 2 private network. VLANs are created implicitly when a configuration
+// interface references a new label and deleted once no instances use them.
+type VLAN struct {
+	Label   string `json:"label"`
+	Region  string `json:"region"`
+	Linodes []uint `json:"linodes"`
+	Created Time   `json:"created"`
+}
+
+// CreateVPCRequest is a parameter struct for creating VPCs. Subnets can be created along with the
+// VPC or added to it later.
+type CreateVPCRequest struct {
+	Label       string                   `json:"label"`
+	Description string                   `json:"description,omitempty"`
+	Region      string                   `json:"region"`
+	Subnets     []CreateVPCSubnetRequest `json:"subnets,omitempty"`
+}
+
+// UpdateVPCRequest is a parameter struct for updating an existing VPC.
+type UpdateVPCRequest struct {
+	ID          uint   `json:"-"`
+	Label       string `json:"label,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// CreateVPCSubnetRequest is a parameter struct for creating VPC subnets. IPv4 is the subnet's
+// range in CIDR notation and must fall within the RFC 1918 private address space.
+type CreateVPCSubnetRequest struct {
+	VPCID uint   `json:"-"`
+	Label string `json:"label"`
+	IPv4  string `json:"ipv4"`
+}
+
+// UpdateVPCSubnetRequest is a parameter struct for updating an existing VPC subnet. Only the label
+// of a subnet can change.
+type UpdateVPCSubnetRequest struct {
+	ID    uint   `json:"-"`
+	VPCID uint   `json:"-"`
+	Label string `json:"label"`
+}
+
+// A VPCer works with VPCs, their subnets, and VLANs.
+type VPCer interface {
+	ListVPCs() ([]VPC, error)
+	ViewVPC(id uint) (VPC, error)
+	CreateVPC(req CreateVPCRequest) (VPC, error)
+	UpdateVPC(req UpdateVPCRequest) (VPC, error)
+	DeleteVPC(id uint) error
+	ListVPCSubnets(vpcID uint) ([]VPCSubnet, error)
+	ViewVPCSubnet(vpcID, subnetID uint) (VPCSubnet, error)
+	CreateVPCSubnet(req CreateVPCSubnetRequest) (VPCSubnet, error)
+	UpdateVPCSubnet(req UpdateVPCSubnetRequest) (VPCSubnet, error)
+	DeleteVPCSubnet(vpcID, subnetID uint) error
+	ListVPCAddresses(vpcID uint) ([]VPCAddress, error)
+	ListVLANs() ([]VLAN, error)
+}
+
+// privateIPv4Ranges are the RFC 1918 private address ranges.
+var privateIPv4Ranges = []netip.Prefix{
+	netip.MustParsePrefix("10.0.0.0/8"),
+	netip.MustParsePrefix("172.16.0.0/12"),
+	netip.MustParsePrefix("192.168.0.0/16"),
+}
+
+// validateSubnetIPv4 makes sure a subnet range is a private IPv4 CIDR, which is all a VPC accepts.
+func validateSubnetIPv4(cidr string) error {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return errors.Wrapf(err, "subnet range %q isn't a valid CIDR", cidr)
+	}
+
+	if !prefix.Addr().Is4() {
+		return errors.Errorf("subnet range %q isn't an IPv4 range", cidr)
+	}
+
+	if prefix.Masked() != prefix {
+		return errors.Errorf("subnet range %q has host bits set, did you mean %s?", cidr, prefix.Masked())
+	}
+
+	for _, private := range privateIPv4Ranges {
+		if prefix.Bits() >= private.Bits() && private.Contains(prefix.Addr()) {
+			return nil
+		}
+	}
+
+	return errors.Errorf("subnet range %q isn't within the RFC 1918 private address space", cidr)
+}
@@ -0,0 +1,238 @@
+package lingo
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// A VPCClient implements the VPCer interface and provides all of the functionality for managing
+// VPCs, their subnets, and VLANs.
+type VPCClient struct {
+	api APIClient
+}
+
+// NewVPCClient returns a new VPCClient given a valid APIClient.
+func NewVPCClient(api APIClient) VPCClient {
+	return VPCClient{api: api}
+}
+
+// ListVPCs retrieves a slice of all VPCs on the account.
+func (c VPCClient) ListVPCs() ([]VPC, error) {
+	data, err := c.api.Get("vpcs")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make request for ListVPCs")
+	}
+
+	var results Results
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, errors.Wrap(err, "failed to decode ListVPCs response")
+	}
+
+	var vpcs []VPC
+	if err := json.Unmarshal(results.Data, &vpcs); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal ListVPCs data")
+	}
+
+	return vpcs, nil
+}
+
+// ViewVPC retrieves a single VPC, including its subnets.
+func (c VPCClient) ViewVPC(id uint) (VPC, error) {
+	var vpc VPC
+	data, err := c.api.Get(fmt.Sprintf("vpcs/%d", id))
+	if err != nil {
+		return vpc, errors.Wrap(err, "failed to make request for ViewVPC")
+	}
+
+	if err := json.Unmarshal(data, &vpc); err != nil {
+		return vpc, errors.Wrap(err, "failed to decode ViewVPC response")
+	}
+
+	return vpc, nil
+}
+
+// CreateVPC creates a new VPC, along with any subnets in the request.
+func (c VPCClient) CreateVPC(req CreateVPCRequest) (VPC, error) {
+	var vpc VPC
+	for _, subnet := range req.Subnets {
+		if err := validateSubnetIPv4(subnet.IPv4); err != nil {
+			return vpc, errors.Wrap(err, "invalid subnet for CreateVPC")
+		}
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return vpc, errors.Wrap(err, "failed to marshal request for CreateVPC")
+	}
+
+	data, err := c.api.Post("vpcs", payload)
+	if err != nil {
+		return vpc, errors.Wrap(err, "failed to make request for CreateVPC")
+	}
+
+	if err := json.Unmarshal(data, &vpc); err != nil {
+		return vpc, errors.Wrap(err, "failed to decode CreateVPC response")
+	}
+
+	return vpc, nil
+}
+
+// UpdateVPC updates the label or description of an existing VPC.
+func (c VPCClient) UpdateVPC(req UpdateVPCRequest) (VPC, error) {
+	var vpc VPC
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return vpc, errors.Wrap(err, "failed to marshal request for UpdateVPC")
+	}
+
+	data, err := c.api.Put(fmt.Sprintf("vpcs/%d", req.ID), payload)
+	if err != nil {
+		return vpc, errors.Wrap(err, "failed to make request for UpdateVPC")
+	}
+
+	if err := json.Unmarshal(data, &vpc); err != nil {
+		return vpc, errors.Wrap(err, "failed to decode UpdateVPC response")
+	}
+
+	return vpc, nil
+}
+
+// DeleteVPC deletes a VPC. A VPC can't be deleted while any Linode instances are attached to it.
+func (c VPCClient) DeleteVPC(id uint) error {
+	if _, err := c.api.Delete(fmt.Sprintf("vpcs/%d", id)); err != nil {
+		return errors.Wrap(err, "failed to make request for DeleteVPC")
+	}
+
+	return nil
+}
+
+// ListVPCSubnets retrieves a slice of the subnets within a VPC.
+func (c VPCClient) ListVPCSubnets(vpcID uint) ([]VPCSubnet, error) {
+	data, err := c.api.Get(fmt.Sprintf("vpcs/%d/subnets", vpcID))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make request for ListVPCSubnets")
+	}
+
+	var results Results
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, errors.Wrap(err, "failed to decode ListVPCSubnets response")
+	}
+
+	var subnets []VPCSubnet
+	if err := json.Unmarshal(results.Data, &subnets); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal ListVPCSubnets data")
+	}
+
+	return subnets, nil
+}
+
+// ViewVPCSubnet retrieves a single subnet within a VPC, including the Linodes attached to it.
+func (c VPCClient) ViewVPCSubnet(vpcID, subnetID uint) (VPCSubnet, error) {
+	var subnet VPCSubnet
+	data, err := c.api.Get(fmt.Sprintf("vpcs/%d/subnets/%d", vpcID, subnetID))
+	if err != nil {
+		return subnet, errors.Wrap(err, "failed to make request for ViewVPCSubnet")
+	}
+
+	if err := json.Unmarshal(data, &subnet); err != nil {
+		return subnet, errors.Wrap(err, "failed to decode ViewVPCSubnet response")
+	}
+
+	return subnet, nil
+}
+
+// CreateVPCSubnet creates a new subnet within an existing VPC.
+func (c VPCClient) CreateVPCSubnet(req CreateVPCSubnetRequest) (VPCSubnet, error) {
+	var subnet VPCSubnet
+	if err := validateSubnetIPv4(req.IPv4); err != nil {
+		return subnet, errors.Wrap(err, "invalid request for CreateVPCSubnet")
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return subnet, errors.Wrap(err, "failed to marshal request for CreateVPCSubnet")
+	}
+
+	data, err := c.api.Post(fmt.Sprintf("vpcs/%d/subnets", req.VPCID), payload)
+	if err != nil {
+		return subnet, errors.Wrap(err, "failed to make request for CreateVPCSubnet")
+	}
+
+	if err := json.Unmarshal(data, &subnet); err != nil {
+		return subnet, errors.Wrap(err, "failed to decode CreateVPCSubnet response")
+	}
+
+	return subnet, nil
+}
+
+// UpdateVPCSubnet updates the label of an existing subnet.
+func (c VPCClient) UpdateVPCSubnet(req UpdateVPCSubnetRequest) (VPCSubnet, error) {
+	var subnet VPCSubnet
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return subnet, errors.Wrap(err, "failed to marshal request for UpdateVPCSubnet")
+	}
+
+	data, err := c.api.Put(fmt.Sprintf("vpcs/%d/subnets/%d", req.VPCID, req.ID), payload)
+	if err != nil {
+		return subnet, errors.Wrap(err, "failed to make request for UpdateVPCSubnet")
+	}
+
+	if err := json.Unmarshal(data, &subnet); err != nil {
+		return subnet, errors.Wrap(err, "failed to decode UpdateVPCSubnet response")
+	}
+
+	return subnet, nil
+}
+
+// DeleteVPCSubnet deletes a subnet. A subnet can't be deleted while any Linode instances are
+// attached to it.
+func (c VPCClient) DeleteVPCSubnet(vpcID, subnetID uint) error {
+	if _, err := c.api.Delete(fmt.Sprintf("vpcs/%d/subnets/%d", vpcID, subnetID)); err != nil {
+		return errors.Wrap(err, "failed to make request for DeleteVPCSubnet")
+	}
+
+	return nil
+}
+
+// ListVPCAddresses retrieves a slice of the addresses Linode instances hold within a VPC.
+func (c VPCClient) ListVPCAddresses(vpcID uint) ([]VPCAddress, error) {
+	data, err := c.api.Get(fmt.Sprintf("vpcs/%d/ips", vpcID))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make request for ListVPCAddresses")
+	}
+
+	var results Results
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, errors.Wrap(err, "failed to decode ListVPCAddresses response")
+	}
+
+	var addrs []VPCAddress
+	if err := json.Unmarshal(results.Data, &addrs); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal ListVPCAddresses data")
+	}
+
+	return addrs, nil
+}
+
+// ListVLANs retrieves a slice of the VLANs currently in use on the account.
+func (c VPCClient) ListVLANs() ([]VLAN, error) {
+	data, err := c.api.Get("networking/vlans")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make request for ListVLANs")
+	}
+
+	var results Results
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, errors.Wrap(err, "failed to decode ListVLANs response")
+	}
+
+	var vlans []VLAN
+	if err := json.Unmarshal(results.Data, &vlans); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal ListVLANs data")
+	}
+
+	return vlans, nil
+}
@@ -0,0 +1,117 @@
+package lingo_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/eriktate/lingo"
+)
+
+func Test_VPCNetworking(t *testing.T) {
+	apiKey := os.Getenv("LINODE_API_KEY")
+	api := lingo.NewAPIClient(apiKey, nil)
+	client := lingo.NewVPCClient(api)
+	configClient := lingo.NewConfigClient(api)
+	linodeClient := lingo.NewLinodeClient(api)
+
+	vpc, err := client.CreateVPC(lingo.CreateVPCRequest{
+		Label:   "lingo-test",
+		Region:  "us-east",
+		Subnets: []lingo.CreateVPCSubnetRequest{{Label: "app", IPv4: "10.0.1.0/24"}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create VPC: %s", err)
+	}
+	defer client.DeleteVPC(vpc.ID)
+
+	if len(vpc.Subnets) != 1 {
+		t.Fatalf("Expected VPC to be created with 1 subnet, but got %d", len(vpc.Subnets))
+	}
+
+	subnet, err := client.CreateVPCSubnet(lingo.CreateVPCSubnetRequest{VPCID: vpc.ID, Label: "db", IPv4: "10.0.2.0/24"})
+	if err != nil {
+		t.Fatalf("Failed to create subnet: %s", err)
+	}
+
+	if _, err := client.UpdateVPC(lingo.UpdateVPCRequest{ID: vpc.ID, Description: "updated"}); err != nil {
+		t.Fatalf("Failed to update VPC: %s", err)
+	}
+
+	testLinode, err := linodeClient.CreateLinode(lingo.CreateLinodeRequest{
+		Region:   "us-east",
+		Type:     "g6-nanode-1",
+		Image:    "linode/debian12",
+		RootPass: "test123-Lingo!",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create linode: %s", err)
+	}
+	defer linodeClient.DeleteLinode(testLinode.ID)
+
+	configs, err := configClient.ListConfigs(testLinode.ID)
+	if err != nil || len(configs) == 0 {
+		t.Fatalf("Failed to list configs: %v", err)
+	}
+
+	vpcInterface := lingo.NewVPCInterface(subnet.ID, "10.0.2.10", true)
+	vpcInterface.Primary = true
+
+	update := lingo.UpdateConfigRequest{
+		ID:         configs[0].ID,
+		LinodeID:   testLinode.ID,
+		Interfaces: lingo.InterfaceList(vpcInterface, lingo.NewVLANInterface("lingo-test", "192.168.50.2/24")),
+	}
+
+	config, err := configClient.UpdateConfig(update)
+	if err != nil {
+		t.Fatalf("Failed to update config interfaces: %s", err)
+	}
+
+	if len(config.Interfaces) != 2 || config.Interfaces[0].VPCID != vpc.ID {
+		t.Fatalf("Expected VPC and VLAN interfaces, but got %+v", config.Interfaces)
+	}
+
+	addrs, err := client.ListVPCAddresses(vpc.ID)
+	if err != nil {
+		t.Fatalf("Failed to list VPC addresses: %s", err)
+	}
+
+	if len(addrs) != 1 || addrs[0].Address != "10.0.2.10" || addrs[0].NAT1To1 == "" {
+		t.Fatalf("Expected a NATed VPC address of 10.0.2.10, but got %+v", addrs)
+	}
+
+	if _, err := client.ListVLANs(); err != nil {
+		t.Fatalf("Failed to list VLANs: %s", err)
+	}
+
+	if err := configClient.DeleteConfigInterface(testLinode.ID, config.ID, config.Interfaces[1].ID); err != nil {
+		t.Fatalf("Failed to delete VLAN interface: %s", err)
+	}
+
+	ifaces, err := configClient.ListConfigInterfaces(testLinode.ID, config.ID)
+	if err != nil {
+		t.Fatalf("Failed to list config interfaces: %s", err)
+	}
+
+	if len(ifaces) != 1 {
+		t.Fatalf("Expected 1 interface after deleting the VLAN, but got %d", len(ifaces))
+	}
+
+	if err := linodeClient.DeleteLinode(testLinode.ID); err != nil {
+		t.Fatalf("Failed to delete linode: %s", err)
+	}
+
+	if err := client.DeleteVPCSubnet(vpc.ID, subnet.ID); err != nil {
+		t.Fatalf("Failed to delete subnet: %s", err)
+	}
+}
+
+func Test_CreateVPCSubnetValidation(t *testing.T) {
+	client := lingo.NewVPCClient(lingo.NewAPIClient("", nil))
+
+	for _, cidr := range []string{"10.0.1.0", "10.0.1.5/24", "8.8.8.0/24", "172.0.0.0/8", "fd00::/64"} {
+		if _, err := client.CreateVPCSubnet(lingo.CreateVPCSubnetRequest{VPCID: 1, Label: "bad", IPv4: cidr}); err == nil {
+			t.Fatalf("Expected subnet range %s to be rejected", cidr)
+		}
+	}
+}
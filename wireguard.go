@@ -0,0 +1,392 @@
+package lingo
+
+import (
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/netip"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// A MeshTopology is an enumeration of the ways a WireGuardMesh can connect its hosts.
+type MeshTopology string
+
+// Enum values for MeshTopology.
+const (
+	MeshTopologyFull     = MeshTopology("full")
+	MeshTopologyHubSpoke = MeshTopology("hub-spoke")
+)
+
+// Defaults for a WireGuardMesh.
+const (
+	defaultWireGuardPort = 51820
+	defaultMeshOverlay   = "10.200.0.0/24"
+)
+
+// A WireGuardKey is a Curve25519 key as used by WireGuard. It marshals to the base64 form wg and
+// wg-quick use.
+type WireGuardKey [32]byte
+
+// GenerateWireGuardKey generates a new private key.
+func GenerateWireGuardKey() (WireGuardKey, error) {
+	var key WireGuardKey
+	private, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return key, errors.Wrap(err, "failed to generate WireGuard key")
+	}
+
+	copy(key[:], private.Bytes())
+	return key, nil
+}
+
+// ParseWireGuardKey parses a base64 encoded key.
+func ParseWireGuardKey(s string) (WireGuardKey, error) {
+	var key WireGuardKey
+	raw, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return key, errors.Wrap(err, "invalid WireGuard key")
+	}
+
+	if len(raw) != len(key) {
+		return key, errors.Errorf("invalid WireGuard key: expected %d bytes, but got %d", len(key), len(raw))
+	}
+
+	copy(key[:], raw)
+	return key, nil
+}
+
+// PublicKey returns the public key for a private key.
+func (k WireGuardKey) PublicKey() WireGuardKey {
+	var public WireGuardKey
+
+	// NewPrivateKey only fails on the wrong length, which a WireGuardKey can't have.
+	private, _ := ecdh.X25519().NewPrivateKey(k[:])
+	copy(public[:], private.PublicKey().Bytes())
+	return public
+}
+
+// String returns the base64 encoded key.
+func (k WireGuardKey) String() string {
+	return base64.StdEncoding.EncodeToString(k[:])
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for WireGuardKeys.
+func (k WireGuardKey) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for WireGuardKeys.
+func (k *WireGuardKey) UnmarshalText(text []byte) error {
+	key, err := ParseWireGuardKey(string(text))
+	if err != nil {
+		return err
+	}
+
+	*k = key
+	return nil
+}
+
+// A MeshPeerState is what a mesh remembers about a host between runs.
+type MeshPeerState struct {
+	PrivateKey WireGuardKey `json:"private_key"`
+	Overlay    netip.Addr   `json:"overlay"`
+}
+
+// A MeshState maps Linode IDs to what the mesh remembers about them. Feeding the state of one
+// build into the next keeps every host's keys and overlay address stable, so only hosts that were
+// added, removed or moved change. The state holds private keys and should be stored accordingly.
+type MeshState map[uint]MeshPeerState
+
+// A MeshHost is a Linode taking part in a mesh.
+type MeshHost struct {
+	Linode     Linode
+	Endpoint   netip.Addr
+	Overlay    netip.Addr
+	PrivateKey WireGuardKey
+}
+
+// A Mesh is a built set of WireGuard hosts that configs can be rendered for.
+type Mesh struct {
+	Hosts               []MeshHost
+	Overlay             netip.Prefix
+	Topology            MeshTopology
+	Hub                 uint
+	ListenPort          uint16
+	PersistentKeepalive uint
+}
+
+// A WireGuardMesh builds an encrypted overlay between Linodes. Hosts are selected by region, tag
+// and label, and reached over their private addresses when UsePrivate is set and they have one,
+// which only works within a region. Otherwise they're reached over their public IPv4 addresses.
+type WireGuardMesh struct {
+	linodes Linoder
+	network Networker
+
+	// Overlay is the prefix overlay addresses are handed out from.
+	Overlay netip.Prefix
+
+	// Topology is how hosts are connected. A hub-and-spoke mesh routes everything through the
+	// Linode with the Hub ID, which needs IP forwarding enabled.
+	Topology MeshTopology
+	Hub      uint
+
+	// Region, Tag and LabelPattern narrow down which Linodes join the mesh when set. LabelPattern
+	// is a shell pattern such as "web-*".
+	Region       string
+	Tag          string
+	LabelPattern string
+
+	UsePrivate          bool
+	ListenPort          uint16
+	PersistentKeepalive uint
+}
+
+// NewWireGuardMesh returns a new full WireGuardMesh given valid Linoder and Networker
+// implementations.
+func NewWireGuardMesh(linodes Linoder, network Networker) WireGuardMesh {
+	return WireGuardMesh{
+		linodes:    linodes,
+		network:    network,
+		Overlay:    netip.MustParsePrefix(defaultMeshOverlay),
+		Topology:   MeshTopologyFull,
+		ListenPort: defaultWireGuardPort,
+	}
+}
+
+// Build selects the mesh's hosts and gives each of them keys and an overlay address, reusing
+// whatever the previous state has for them. A nil state builds a fresh mesh.
+func (w WireGuardMesh) Build(state MeshState) (Mesh, error) {
+	mesh := Mesh{
+		Overlay:             w.Overlay.Masked(),
+		Topology:            w.Topology,
+		Hub:                 w.Hub,
+		ListenPort:          w.ListenPort,
+		PersistentKeepalive: w.PersistentKeepalive,
+	}
+
+	if w.Topology != MeshTopologyFull && w.Topology != MeshTopologyHubSpoke {
+		return mesh, errors.Errorf("unknown mesh topology %q", w.Topology)
+	}
+
+	linodes, err := w.selectLinodes()
+	if err != nil {
+		return mesh, err
+	}
+
+	endpoints, err := w.endpoints()
+	if err != nil {
+		return mesh, err
+	}
+
+	ipam, err := NewIPAM(mesh.Overlay)
+	if err != nil {
+		return mesh, errors.Wrap(err, "invalid mesh overlay")
+	}
+
+	// Hosts that keep their state claim their addresses before any new host is given one.
+	var fresh []int
+	for i, linode := range linodes {
+		host := MeshHost{Linode: linode, Endpoint: endpoints[linode.ID]}
+		if !host.Endpoint.IsValid() {
+			return mesh, errors.Errorf("Linode %s has no usable address to reach it on", linode.Label)
+		}
+
+		previous, ok := state[linode.ID]
+		if ok && ipam.Assign(meshService(linode.ID), previous.Overlay) == nil {
+			host.Overlay = previous.Overlay
+			host.PrivateKey = previous.PrivateKey
+		} else {
+			fresh = append(fresh, i)
+		}
+
+		mesh.Hosts = append(mesh.Hosts, host)
+	}
+
+	for _, i := range fresh {
+		host := &mesh.Hosts[i]
+		if host.Overlay, err = ipam.Allocate(meshService(host.Linode.ID)); err != nil {
+			return mesh, err
+		}
+
+		if previous, ok := state[host.Linode.ID]; ok {
+			host.PrivateKey = previous.PrivateKey
+		} else if host.PrivateKey, err = GenerateWireGuardKey(); err != nil {
+			return mesh, err
+		}
+	}
+
+	if mesh.Topology == MeshTopologyHubSpoke {
+		if _, ok := mesh.host(mesh.Hub); !ok {
+			return mesh, errors.Errorf("hub Linode %d isn't part of the mesh", mesh.Hub)
+		}
+	}
+
+	return mesh, nil
+}
+
+// selectLinodes lists the Linodes that belong in the mesh, ordered by ID.
+func (w WireGuardMesh) selectLinodes() ([]Linode, error) {
+	if w.LabelPattern != "" {
+		if _, err := path.Match(w.LabelPattern, ""); err != nil {
+			return nil, errors.Wrapf(err, "invalid label pattern %q", w.LabelPattern)
+		}
+	}
+
+	var (
+		linodes []Linode
+		err     error
+	)
+
+	if w.Tag != "" {
+		linodes, err = w.linodes.ListLinodesByTag(w.Tag)
+	} else {
+		linodes, err = w.linodes.ListLinodes()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var selected []Linode
+	for _, linode := range linodes {
+		if w.Region != "" && linode.Region != w.Region {
+			continue
+		}
+
+		if w.LabelPattern != "" {
+			if ok, _ := path.Match(w.LabelPattern, linode.Label); !ok {
+				continue
+			}
+		}
+
+		selected = append(selected, linode)
+	}
+
+	sort.Slice(selected, func(i, j int) bool { return selected[i].ID < selected[j].ID })
+	return selected, nil
+}
+
+// endpoints picks the address each Linode should be reached on, keyed by Linode ID. Where a
+// Linode has more than one candidate, the lowest address wins so the choice is stable.
+func (w WireGuardMesh) endpoints() (map[uint]netip.Addr, error) {
+	addresses, err := w.network.ListAddresses()
+	if err != nil {
+		return nil, err
+	}
+
+	public := make(map[uint]netip.Addr)
+	private := make(map[uint]netip.Addr)
+	for _, address := range addresses {
+		addr, err := address.Addr()
+		if err != nil || address.Type != IPv4 {
+			continue
+		}
+
+		candidates := public
+		if !address.Public {
+			candidates = private
+		}
+
+		if current, ok := candidates[address.LinodeID]; !ok || addr.Less(current) {
+			candidates[address.LinodeID] = addr
+		}
+	}
+
+	if w.UsePrivate {
+		for id, addr := range private {
+			public[id] = addr
+		}
+	}
+
+	return public, nil
+}
+
+// meshService names a host's overlay address in the mesh's IPAM.
+func meshService(linodeID uint) string {
+	return fmt.Sprintf("linode-%d", linodeID)
+}
+
+// State returns what should be remembered about the mesh for the next build.
+func (m Mesh) State() MeshState {
+	state := make(MeshState, len(m.Hosts))
+	for _, host := range m.Hosts {
+		state[host.Linode.ID] = MeshPeerState{PrivateKey: host.PrivateKey, Overlay: host.Overlay}
+	}
+
+	return state
+}
+
+// Config renders the wg-quick config for a single host. Peers are listed in Linode ID order so the
+// same mesh always renders the same config.
+func (m Mesh) Config(linodeID uint) (string, error) {
+	self, ok := m.host(linodeID)
+	if !ok {
+		return "", errors.Errorf("Linode %d isn't part of the mesh", linodeID)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s (Linode %d)\n[Interface]\n", self.Linode.Label, self.Linode.ID)
+	fmt.Fprintf(&b, "PrivateKey = %s\n", self.PrivateKey)
+	fmt.Fprintf(&b, "Address = %s\n", netip.PrefixFrom(self.Overlay, m.Overlay.Bits()))
+	fmt.Fprintf(&b, "ListenPort = %d\n", m.ListenPort)
+
+	hubSpoke := m.Topology == MeshTopologyHubSpoke
+	if hubSpoke && self.Linode.ID == m.Hub {
+		forwarding := "net.ipv4.ip_forward"
+		if m.Overlay.Addr().Is6() {
+			forwarding = "net.ipv6.conf.all.forwarding"
+		}
+
+		fmt.Fprintf(&b, "PostUp = sysctl -w %s=1\n", forwarding)
+	}
+
+	for _, peer := range m.Hosts {
+		if peer.Linode.ID == self.Linode.ID {
+			continue
+		}
+
+		// Spokes only talk to the hub, which routes the whole overlay for them.
+		allowed := netip.PrefixFrom(peer.Overlay, peer.Overlay.BitLen())
+		if hubSpoke && self.Linode.ID != m.Hub {
+			if peer.Linode.ID != m.Hub {
+				continue
+			}
+			allowed = m.Overlay
+		}
+
+		fmt.Fprintf(&b, "\n# %s (Linode %d)\n[Peer]\n", peer.Linode.Label, peer.Linode.ID)
+		fmt.Fprintf(&b, "PublicKey = %s\n", peer.PrivateKey.PublicKey())
+		fmt.Fprintf(&b, "Endpoint = %s\n", netip.AddrPortFrom(peer.Endpoint, m.ListenPort))
+		fmt.Fprintf(&b, "AllowedIPs = %s\n", allowed)
+		if m.PersistentKeepalive > 0 {
+			fmt.Fprintf(&b, "PersistentKeepalive = %d\n", m.PersistentKeepalive)
+		}
+	}
+
+	return b.String(), nil
+}
+
+// Configs renders the wg-quick config of every host, keyed by Linode ID.
+func (m Mesh) Configs() map[uint]string {
+	configs := make(map[uint]string, len(m.Hosts))
+	for _, host := range m.Hosts {
+		configs[host.Linode.ID], _ = m.Config(host.Linode.ID)
+	}
+
+	return configs
+}
+
+func (m Mesh) host(linodeID uint) (MeshHost, bool) {
+	for _, host := range m.Hosts {
+		if host.Linode.ID == linodeID {
+			return host, true
+		}
+	}
+
+	return MeshHost{}, false
+}
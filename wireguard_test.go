@@ -0,0 +1,165 @@
+package lingo_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/eriktate/lingo"
+)
+
+// fakeAddresses serves a fixed list of addresses.
+type fakeAddresses struct {
+	lingo.Networker
+	addresses []lingo.Address
+}
+
+func (f fakeAddresses) ListAddresses() ([]lingo.Address, error) {
+	return f.addresses, nil
+}
+
+func testMeshNetwork() fakeAddresses {
+	return fakeAddresses{addresses: []lingo.Address{
+		{Address: "192.0.2.10", Type: lingo.IPv4, Public: true, LinodeID: 1},
+		{Address: "192.168.130.10", Type: lingo.IPv4, LinodeID: 1},
+		{Address: "192.0.2.20", Type: lingo.IPv4, Public: true, LinodeID: 2},
+		{Address: "2001:db8::20", Type: lingo.IPv6, Public: true, LinodeID: 2},
+		{Address: "192.0.2.31", Type: lingo.IPv4, Public: true, LinodeID: 3},
+		{Address: "192.0.2.30", Type: lingo.IPv4, Public: true, LinodeID: 3},
+		{Address: "192.0.2.40", Type: lingo.IPv4, Public: true, LinodeID: 4},
+	}}
+}
+
+func testMeshLinodes() []lingo.Linode {
+	return []lingo.Linode{
+		{ID: 3, Label: "web-3", Region: "us-east", Tags: []string{"web"}},
+		{ID: 1, Label: "web-1", Region: "us-east", Tags: []string{"web"}},
+		{ID: 2, Label: "web-2", Region: "us-east", Tags: []string{"web"}},
+		{ID: 4, Label: "db-1", Region: "eu-west"},
+	}
+}
+
+func Test_WireGuardKey(t *testing.T) {
+	// The key pair from the wg(8) examples.
+	private, err := lingo.ParseWireGuardKey("yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBmk=")
+	if err != nil {
+		t.Fatalf("Failed to parse key: %s", err)
+	}
+
+	if public := private.PublicKey().String(); public != "HIgo9xNzJMWLKASShiTqIybxZ0U3wGLiUeJ1PKf8ykw=" {
+		t.Fatalf("Expected the known public key, but got %s", public)
+	}
+
+	if _, err := lingo.ParseWireGuardKey("c2hvcnQ="); err == nil {
+		t.Fatal("Expected a short key to be rejected")
+	}
+}
+
+func Test_WireGuardFullMesh(t *testing.T) {
+	w := lingo.NewWireGuardMesh(fakeLinodes{linodes: testMeshLinodes()}, testMeshNetwork())
+	w.Region = "us-east"
+
+	mesh, err := w.Build(nil)
+	if err != nil {
+		t.Fatalf("Failed to build mesh: %s", err)
+	}
+
+	if len(mesh.Hosts) != 3 || mesh.Hosts[0].Linode.ID != 1 || mesh.Hosts[2].Overlay.String() != "10.200.0.3" {
+		t.Fatalf("Expected 3 hosts in ID order, but got %+v", mesh.Hosts)
+	}
+
+	config, err := mesh.Config(1)
+	if err != nil {
+		t.Fatalf("Failed to render config: %s", err)
+	}
+
+	for _, expected := range []string{
+		"PrivateKey = " + mesh.Hosts[0].PrivateKey.String(),
+		"Address = 10.200.0.1/24\nListenPort = 51820\n",
+		"# web-2 (Linode 2)\n[Peer]\nPublicKey = " + mesh.Hosts[1].PrivateKey.PublicKey().String() + "\nEndpoint = 192.0.2.20:51820\nAllowedIPs = 10.200.0.2/32\n",
+		"Endpoint = 192.0.2.30:51820",
+	} {
+		if !strings.Contains(config, expected) {
+			t.Fatalf("Expected config to contain %q, but got:\n%s", expected, config)
+		}
+	}
+
+	if strings.Contains(config, "db-1") || strings.Contains(config, "PostUp") {
+		t.Fatalf("Expected only the us-east web hosts as peers, but got:\n%s", config)
+	}
+
+	// Rebuilding from the state should change nothing.
+	again, err := w.Build(mesh.State())
+	if err != nil {
+		t.Fatalf("Failed to rebuild mesh: %s", err)
+	}
+
+	before, after := mesh.Configs(), again.Configs()
+	for id := range before {
+		if before[id] != after[id] {
+			t.Fatalf("Expected rebuilding to keep the config of Linode %d:\n%s\nbut got:\n%s", id, before[id], after[id])
+		}
+	}
+
+	// Dropping web-2 and adding db-1 should hand db-1 the freed address and leave the others alone.
+	state := mesh.State()
+	linodes := append(testMeshLinodes(), lingo.Linode{ID: 5, Label: "db-2"})
+	w = lingo.NewWireGuardMesh(fakeLinodes{linodes: linodes}, testMeshNetwork())
+	w.LabelPattern = "*-[123]"
+
+	moved, err := w.Build(state)
+	if err == nil {
+		t.Fatalf("Expected a host without addresses to fail the build, but got %+v", moved.Hosts)
+	}
+
+	w.LabelPattern = "[dw]*-[13]"
+	moved, err = w.Build(state)
+	if err != nil {
+		t.Fatalf("Failed to build moved mesh: %s", err)
+	}
+
+	if len(moved.Hosts) != 3 {
+		t.Fatalf("Expected web-1, web-3 and db-1, but got %+v", moved.Hosts)
+	}
+
+	for _, host := range moved.Hosts {
+		switch host.Linode.ID {
+		case 1, 3:
+			if host.Overlay != state[host.Linode.ID].Overlay || host.PrivateKey != state[host.Linode.ID].PrivateKey {
+				t.Fatalf("Expected Linode %d to keep its overlay address and key", host.Linode.ID)
+			}
+		case 4:
+			if host.Overlay.String() != "10.200.0.2" {
+				t.Fatalf("Expected db-1 to get web-2's freed address, but got %s", host.Overlay)
+			}
+		}
+	}
+}
+
+func Test_WireGuardHubSpoke(t *testing.T) {
+	w := lingo.NewWireGuardMesh(fakeLinodes{linodes: testMeshLinodes()}, testMeshNetwork())
+	w.Tag = "web"
+	w.Topology = lingo.MeshTopologyHubSpoke
+	w.Hub = 1
+	w.UsePrivate = true
+	w.PersistentKeepalive = 25
+
+	mesh, err := w.Build(nil)
+	if err != nil {
+		t.Fatalf("Failed to build mesh: %s", err)
+	}
+
+	hub, _ := mesh.Config(1)
+	if strings.Count(hub, "[Peer]") != 2 || !strings.Contains(hub, "PostUp = sysctl -w net.ipv4.ip_forward=1") {
+		t.Fatalf("Expected the hub to peer with both spokes and forward, but got:\n%s", hub)
+	}
+
+	spoke, _ := mesh.Config(2)
+	if strings.Count(spoke, "[Peer]") != 1 || !strings.Contains(spoke, "Endpoint = 192.168.130.10:51820\nAllowedIPs = 10.200.0.0/24\nPersistentKeepalive = 25\n") {
+		t.Fatalf("Expected the spoke to route the overlay through the hub's private address, but got:\n%s", spoke)
+	}
+
+	w.Hub = 4
+	if _, err := w.Build(nil); err == nil {
+		t.Fatal("Expected a hub outside the mesh to be rejected")
+	}
+}
@@ -0,0 +1,788 @@
+package lingo
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// The nameservers Linode serves every master zone from. They're written into exported zones and
+// skipped when importing, since Linode manages them itself.
+var linodeNameservers = []string{
+	"ns1.linode.com",
+	"ns2.linode.com",
+	"ns3.linode.com",
+	"ns4.linode.com",
+	"ns5.linode.com",
+}
+
+// Linode's defaults for the SOA timers when a Domain leaves them unset.
+const (
+	defaultZoneTTL     = 86400
+	defaultZoneRefresh = 14400
+	defaultZoneRetry   = 3600
+	defaultZoneExpire  = 1209600
+)
+
+// A Zone is a Domain along with all of its DomainRecords. It can be written out as an RFC 1035
+// zone file, or parsed from one with ParseZone and imported with DomainClient.ImportZone.
+type Zone struct {
+	Domain  Domain
+	Records []DomainRecord
+
+	// Serial is the SOA serial written on export. Linode doesn't expose its own serial, so a zero
+	// value falls back to today's date in the conventional YYYYMMDDnn form.
+	Serial uint32
+}
+
+// WriteTo implements the io.WriterTo interface, writing the Zone in BIND zone file format.
+func (z Zone) WriteTo(w io.Writer) (int64, error) {
+	var buf bytes.Buffer
+
+	origin := strings.TrimSuffix(z.Domain.Domain, ".") + "."
+	serial := z.Serial
+	if serial == 0 {
+		today, _ := strconv.ParseUint(time.Now().UTC().Format("20060102")+"00", 10, 32)
+		serial = uint32(today)
+	}
+
+	fmt.Fprintf(&buf, "$ORIGIN %s\n", origin)
+	fmt.Fprintf(&buf, "$TTL %d\n", zoneDefault(z.Domain.TTLSec, defaultZoneTTL))
+	fmt.Fprintf(&buf, "@\tIN\tSOA\t%s. %s (\n", linodeNameservers[0], zoneMailbox(z.Domain.SOA))
+	fmt.Fprintf(&buf, "\t\t%d\t; serial\n", serial)
+	fmt.Fprintf(&buf, "\t\t%d\t; refresh\n", zoneDefault(z.Domain.RefreshSec, defaultZoneRefresh))
+	fmt.Fprintf(&buf, "\t\t%d\t; retry\n", zoneDefault(z.Domain.RetrySec, defaultZoneRetry))
+	fmt.Fprintf(&buf, "\t\t%d\t; expire\n", zoneDefault(z.Domain.ExpireSec, defaultZoneExpire))
+	fmt.Fprintf(&buf, "\t\t%d\t; minimum\n", zoneDefault(z.Domain.TTLSec, defaultZoneTTL))
+	buf.WriteString("\t\t)\n")
+
+	for _, ns := range linodeNameservers {
+		fmt.Fprintf(&buf, "@\tIN\tNS\t%s.\n", ns)
+	}
+
+	for _, record := range z.Records {
+		line, err := zoneRecordLine(record, z.Domain.Domain)
+		if err != nil {
+			return 0, err
+		}
+
+		buf.WriteString(line)
+		buf.WriteByte('\n')
+	}
+
+	n, err := w.Write(buf.Bytes())
+	return int64(n), err
+}
+
+// String returns the Zone in BIND zone file format.
+func (z Zone) String() string {
+	var buf bytes.Buffer
+	if _, err := z.WriteTo(&buf); err != nil {
+		return ""
+	}
+
+	return buf.String()
+}
+
+// zoneDefault returns the value, or the fallback if the value is unset.
+func zoneDefault(value, fallback uint) uint {
+	if value == 0 {
+		return fallback
+	}
+
+	return value
+}
+
+// zoneMailbox converts an email address into the mailbox form used in an SOA record, escaping any
+// dots in the local part.
+func zoneMailbox(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return strings.TrimSuffix(email, ".") + "."
+	}
+
+	local := strings.Replace(email[:at], ".", `\.`, -1)
+	return local + "." + strings.TrimSuffix(email[at+1:], ".") + "."
+}
+
+// zoneRecordLine renders a single DomainRecord as a zone file line.
+func zoneRecordLine(record DomainRecord, domain string) (string, error) {
+	owner := recordOwner(record)
+	if owner == "" {
+		owner = "@"
+	}
+
+	ttl := ""
+	if record.TTLSec != 0 {
+		ttl = strconv.FormatUint(uint64(record.TTLSec), 10)
+	}
+
+	var rdata string
+	switch record.Type {
+	case A, AAAA:
+		rdata = record.Target
+	case CNAME, NS, PTR:
+		rdata = zoneHost(record.Target, domain)
+	case MX:
+		rdata = fmt.Sprintf("%d %s", record.Priority, zoneHost(record.Target, domain))
+	case TXT:
+		rdata = zoneQuote(record.Target)
+	case SRV:
+		rdata = fmt.Sprintf("%d %d %d %s", record.Priority, record.Weight, record.Port, zoneHost(record.Target, domain))
+	case CAA:
+		rdata = fmt.Sprintf("0 %s %s", record.Tag, zoneQuote(record.Target))
+	default:
+		return "", errors.Errorf("can't export unsupported record type %q", record.Type)
+	}
+
+	return fmt.Sprintf("%s\t%s\tIN\t%s\t%s", owner, ttl, record.Type, rdata), nil
+}
+
+// recordOwner returns the full name a record is served at, relative to the zone apex. Linode keeps
+// the service and protocol of SRV records out of the Name, so they're added back here.
+func recordOwner(record DomainRecord) string {
+	owner := record.Name
+	if record.Type == SRV {
+		prefix := "_" + strings.TrimPrefix(record.Service, "_") + "._" + strings.TrimPrefix(record.Protocol, "_")
+		if !strings.HasPrefix(owner, prefix) {
+			owner = strings.TrimSuffix(prefix+"."+owner, ".")
+		}
+	}
+
+	return owner
+}
+
+// zoneHost renders a hostname target. Linode stores fully qualified names without the trailing
+// dot, while bare labels are relative to the zone.
+func zoneHost(target, domain string) string {
+	switch {
+	case target == "" || strings.EqualFold(target, domain):
+		return "@"
+	case strings.HasSuffix(target, "."):
+		return target
+	case strings.Contains(target, "."):
+		return target + "."
+	default:
+		return target
+	}
+}
+
+// zoneQuote renders text as one or more quoted character-strings, splitting it into the 255 byte
+// chunks a single character-string is limited to.
+func zoneQuote(text string) string {
+	var chunks []string
+	for len(text) > 255 {
+		chunks = append(chunks, text[:255])
+		text = text[255:]
+	}
+	chunks = append(chunks, text)
+
+	quoted := make([]string, len(chunks))
+	for i, chunk := range chunks {
+		var b strings.Builder
+		b.WriteByte('"')
+		for j := 0; j < len(chunk); j++ {
+			c := chunk[j]
+			switch {
+			case c == '"' || c == '\\':
+				b.WriteByte('\\')
+				b.WriteByte(c)
+			case c < ' ' || c > '~':
+				fmt.Fprintf(&b, "\\%03d", c)
+			default:
+				b.WriteByte(c)
+			}
+		}
+		b.WriteByte('"')
+		quoted[i] = b.String()
+	}
+
+	return strings.Join(quoted, " ")
+}
+
+// A zoneToken is a single field of a zone file entry. Quoted tokens have already been unescaped.
+type zoneToken struct {
+	text   string
+	quoted bool
+}
+
+// A zoneParser holds the state that carries between the entries of a zone file.
+type zoneParser struct {
+	origin    string
+	ttl       uint
+	hasTTL    bool
+	lastOwner string
+	zone      Zone
+
+	// inherited holds the indexes of records whose TTL came from $TTL rather than their own entry.
+	inherited []int
+}
+
+// ParseZone parses a BIND zone file into a Zone that can be passed to DomainClient.ImportZone. The
+// origin is used until the file sets its own with $ORIGIN, and may be empty if it always does.
+// Records without an explicit TTL are left to inherit the Domain's default unless the $TTL in effect
+// for them differs from it, and the apex NS records for Linode's own nameservers are skipped.
+func ParseZone(r io.Reader, origin string) (Zone, error) {
+	p := zoneParser{origin: zoneFQDN(origin)}
+
+	scanner := bufio.NewScanner(r)
+	var (
+		entry     []zoneToken
+		indented  bool
+		depth     int
+		lineNum   int
+		entryLine int
+	)
+
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Text()
+
+		if depth == 0 {
+			entryLine = lineNum
+			indented = len(line) > 0 && (line[0] == ' ' || line[0] == '\t')
+		}
+
+		tokens, delta, err := tokenizeZoneLine(line)
+		if err != nil {
+			return p.zone, errors.Wrapf(err, "line %d", lineNum)
+		}
+
+		entry = append(entry, tokens...)
+		depth += delta
+		if depth < 0 {
+			return p.zone, errors.Errorf("line %d: unbalanced parentheses", lineNum)
+		}
+
+		if depth > 0 || len(entry) == 0 {
+			continue
+		}
+
+		if err := p.parseEntry(entry, indented); err != nil {
+			return p.zone, errors.Wrapf(err, "line %d", entryLine)
+		}
+
+		entry = nil
+	}
+
+	if err := scanner.Err(); err != nil {
+		return p.zone, errors.Wrap(err, "failed to read zone file")
+	}
+
+	if depth != 0 {
+		return p.zone, errors.New("unexpected end of zone file inside parentheses")
+	}
+
+	if p.zone.Domain.Domain == "" {
+		if p.origin == "" {
+			return p.zone, errors.New("zone file has no SOA record and no origin")
+		}
+
+		p.zone.Domain.Domain = strings.TrimSuffix(p.origin, ".")
+	}
+
+	if p.zone.Domain.TTLSec == 0 && p.hasTTL {
+		p.zone.Domain.TTLSec = p.ttl
+	}
+
+	for _, i := range p.inherited {
+		if p.zone.Records[i].TTLSec == p.zone.Domain.TTLSec {
+			p.zone.Records[i].TTLSec = 0
+		}
+	}
+
+	return p.zone, nil
+}
+
+// tokenizeZoneLine splits a single line into tokens, dropping comments and reporting how the line
+// changes the parenthesis depth.
+func tokenizeZoneLine(line string) ([]zoneToken, int, error) {
+	var (
+		tokens []zoneToken
+		delta  int
+		field  strings.Builder
+		inWord bool
+	)
+
+	flush := func() {
+		if inWord {
+			tokens = append(tokens, zoneToken{text: field.String()})
+			field.Reset()
+			inWord = false
+		}
+	}
+
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case c == ';':
+			flush()
+			return tokens, delta, nil
+		case c == '(' || c == ')':
+			flush()
+			if c == '(' {
+				delta++
+			} else {
+				delta--
+			}
+		case c == ' ' || c == '\t' || c == '\r':
+			flush()
+		case c == '"':
+			flush()
+			text, end, err := unquoteZoneString(line, i+1)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			tokens = append(tokens, zoneToken{text: text, quoted: true})
+			i = end
+		case c == '\\' && i+1 < len(line):
+			// Escapes outside of quotes are kept as they are so names like "host\.master" survive.
+			field.WriteByte(c)
+			field.WriteByte(line[i+1])
+			inWord = true
+			i++
+		default:
+			field.WriteByte(c)
+			inWord = true
+		}
+	}
+
+	flush()
+	return tokens, delta, nil
+}
+
+// unquoteZoneString reads a quoted character-string starting just after its opening quote,
+// returning the unescaped text and the index of the closing quote.
+func unquoteZoneString(line string, start int) (string, int, error) {
+	var b strings.Builder
+
+	for i := start; i < len(line); i++ {
+		c := line[i]
+		switch c {
+		case '"':
+			return b.String(), i, nil
+		case '\\':
+			if i+3 < len(line) && isDigits(line[i+1:i+4]) {
+				value, _ := strconv.Atoi(line[i+1 : i+4])
+				if value > 255 {
+					return "", 0, errors.Errorf("invalid escape \\%s", line[i+1:i+4])
+				}
+
+				b.WriteByte(byte(value))
+				i += 3
+				continue
+			}
+
+			if i+1 < len(line) {
+				b.WriteByte(line[i+1])
+				i++
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	return "", 0, errors.New("unterminated quoted string")
+}
+
+// isDigits reports whether a string is made entirely of ASCII digits.
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	return len(s) > 0
+}
+
+// parseZoneTTL parses a TTL, which may be a plain number of seconds or use BIND's unit suffixes
+// such as "1h30m".
+func parseZoneTTL(s string) (uint, bool) {
+	if s == "" || s[0] < '0' || s[0] > '9' {
+		return 0, false
+	}
+
+	if isDigits(s) {
+		value, err := strconv.ParseUint(s, 10, 32)
+		return uint(value), err == nil
+	}
+
+	var total, current uint
+	var seenDigit bool
+	for _, c := range strings.ToLower(s) {
+		if c >= '0' && c <= '9' {
+			current = current*10 + uint(c-'0')
+			seenDigit = true
+			continue
+		}
+
+		if !seenDigit {
+			return 0, false
+		}
+
+		switch c {
+		case 's':
+			total += current
+		case 'm':
+			total += current * 60
+		case 'h':
+			total += current * 3600
+		case 'd':
+			total += current * 86400
+		case 'w':
+			total += current * 604800
+		default:
+			return 0, false
+		}
+
+		current = 0
+		seenDigit = false
+	}
+
+	if seenDigit {
+		return 0, false
+	}
+
+	return total, true
+}
+
+// zoneFQDN returns a name with exactly one trailing dot, or an empty string for an empty name.
+func zoneFQDN(name string) string {
+	if name == "" {
+		return ""
+	}
+
+	return strings.TrimSuffix(name, ".") + "."
+}
+
+// absolute resolves a possibly relative name against the current origin.
+func (p *zoneParser) absolute(name string) (string, error) {
+	if strings.HasSuffix(name, ".") && !strings.HasSuffix(name, `\.`) {
+		return name, nil
+	}
+
+	if p.origin == "" {
+		return "", errors.Errorf("relative name %q used before $ORIGIN", name)
+	}
+
+	if name == "@" {
+		return p.origin, nil
+	}
+
+	return name + "." + p.origin, nil
+}
+
+// relative converts a name into the form Linode expects for a record's Name, relative to the zone
+// apex with the apex itself being empty.
+func (p *zoneParser) relative(name string) (string, error) {
+	abs, err := p.absolute(name)
+	if err != nil {
+		return "", err
+	}
+
+	apex := zoneFQDN(p.zone.Domain.Domain)
+	if apex == "" {
+		apex = p.origin
+	}
+
+	lowered := strings.ToLower(abs)
+	lowerApex := strings.ToLower(apex)
+	if lowered == lowerApex {
+		return "", nil
+	}
+
+	if !strings.HasSuffix(lowered, "."+lowerApex) {
+		return "", errors.Errorf("name %q is outside of zone %q", abs, apex)
+	}
+
+	return abs[:len(abs)-len(apex)-1], nil
+}
+
+// host converts a target name into the fully qualified form Linode stores, without a trailing dot.
+func (p *zoneParser) host(name string) (string, error) {
+	abs, err := p.absolute(name)
+	if err != nil {
+		return "", err
+	}
+
+	// The root name stays as "." so an SRV target of "." still means "no service".
+	if abs == "." {
+		return abs, nil
+	}
+
+	return strings.TrimSuffix(abs, "."), nil
+}
+
+// parseEntry handles a single directive or resource record.
+func (p *zoneParser) parseEntry(tokens []zoneToken, indented bool) error {
+	switch strings.ToUpper(tokens[0].text) {
+	case "$ORIGIN":
+		if len(tokens) != 2 {
+			return errors.New("$ORIGIN takes exactly one name")
+		}
+
+		origin, err := p.absolute(tokens[1].text)
+		if err != nil {
+			return err
+		}
+
+		p.origin = origin
+		return nil
+	case "$TTL":
+		if len(tokens) != 2 {
+			return errors.New("$TTL takes exactly one value")
+		}
+
+		ttl, ok := parseZoneTTL(tokens[1].text)
+		if !ok {
+			return errors.Errorf("invalid $TTL %q", tokens[1].text)
+		}
+
+		p.ttl = ttl
+		p.hasTTL = true
+		return nil
+	case "$INCLUDE", "$GENERATE":
+		return errors.Errorf("%s isn't supported", tokens[0].text)
+	}
+
+	owner := p.lastOwner
+	if !indented {
+		owner = tokens[0].text
+		tokens = tokens[1:]
+	}
+
+	if owner == "" {
+		return errors.New("record has no owner name")
+	}
+	p.lastOwner = owner
+
+	var (
+		ttl    uint
+		hasTTL bool
+	)
+
+	for len(tokens) > 0 {
+		if value, ok := parseZoneTTL(tokens[0].text); ok && !hasTTL {
+			ttl, hasTTL = value, true
+		} else if strings.EqualFold(tokens[0].text, "IN") {
+			// IN is the only class Linode serves.
+		} else {
+			break
+		}
+
+		tokens = tokens[1:]
+	}
+
+	if len(tokens) == 0 {
+		return errors.New("record has no type")
+	}
+
+	recordType := DomainRecordType(strings.ToUpper(tokens[0].text))
+	rdata := tokens[1:]
+
+	if recordType == "SOA" {
+		return p.parseSOA(owner, rdata)
+	}
+
+	record, skip, err := p.parseRecord(owner, recordType, rdata)
+	if err != nil || skip {
+		return err
+	}
+
+	if hasTTL {
+		record.TTLSec = ttl
+	} else if p.hasTTL {
+		// The Domain's default isn't settled until the SOA, so this is undone later if they match.
+		record.TTLSec = p.ttl
+		p.inherited = append(p.inherited, len(p.zone.Records))
+	}
+
+	p.zone.Records = append(p.zone.Records, record)
+	return nil
+}
+
+// parseSOA fills in the Domain from the zone's SOA record.
+func (p *zoneParser) parseSOA(owner string, rdata []zoneToken) error {
+	if len(rdata) != 7 {
+		return errors.Errorf("SOA record needs 7 fields but has %d", len(rdata))
+	}
+
+	apex, err := p.absolute(owner)
+	if err != nil {
+		return err
+	}
+
+	var timers [4]uint
+	for i := range timers {
+		value, ok := parseZoneTTL(rdata[3+i].text)
+		if !ok {
+			return errors.Errorf("invalid SOA timer %q", rdata[3+i].text)
+		}
+
+		timers[i] = value
+	}
+
+	p.zone.Domain.Domain = strings.TrimSuffix(apex, ".")
+	p.zone.Domain.Type = DomainTypeMaster
+	p.zone.Domain.SOA = zoneEmail(rdata[1].text)
+	p.zone.Domain.RefreshSec = timers[0]
+	p.zone.Domain.RetrySec = timers[1]
+	p.zone.Domain.ExpireSec = timers[2]
+	p.zone.Domain.TTLSec = timers[3]
+
+	if p.hasTTL {
+		p.zone.Domain.TTLSec = p.ttl
+	}
+
+	return nil
+}
+
+// zoneEmail converts an SOA mailbox back into an email address. The first unescaped dot separates
+// the local part from the domain.
+func zoneEmail(mailbox string) string {
+	mailbox = strings.TrimSuffix(mailbox, ".")
+
+	for i := 0; i < len(mailbox); i++ {
+		if mailbox[i] == '\\' {
+			i++
+			continue
+		}
+
+		if mailbox[i] == '.' {
+			local := strings.Replace(mailbox[:i], `\.`, ".", -1)
+			return local + "@" + mailbox[i+1:]
+		}
+	}
+
+	return mailbox
+}
+
+// parseRecord converts the rdata of a single resource record into a DomainRecord. Records that
+// Linode manages itself are reported as skipped.
+func (p *zoneParser) parseRecord(owner string, recordType DomainRecordType, rdata []zoneToken) (DomainRecord, bool, error) {
+	record := DomainRecord{Type: recordType}
+
+	name, err := p.relative(owner)
+	if err != nil {
+		return record, false, err
+	}
+	record.Name = name
+
+	expect := func(count int) error {
+		if len(rdata) != count {
+			return errors.Errorf("%s record needs %d fields but has %d", recordType, count, len(rdata))
+		}
+
+		return nil
+	}
+
+	switch recordType {
+	case A, AAAA:
+		if err := expect(1); err != nil {
+			return record, false, err
+		}
+
+		record.Target = rdata[0].text
+	case CNAME, NS, PTR:
+		if err := expect(1); err != nil {
+			return record, false, err
+		}
+
+		if record.Target, err = p.host(rdata[0].text); err != nil {
+			return record, false, err
+		}
+
+		if recordType == NS && record.Name == "" && isLinodeNameserver(record.Target) {
+			return record, true, nil
+		}
+	case MX:
+		if err := expect(2); err != nil {
+			return record, false, err
+		}
+
+		priority, err := strconv.ParseUint(rdata[0].text, 10, 8)
+		if err != nil {
+			return record, false, errors.Errorf("invalid MX preference %q", rdata[0].text)
+		}
+
+		record.Priority = uint8(priority)
+		if record.Target, err = p.host(rdata[1].text); err != nil {
+			return record, false, err
+		}
+	case TXT:
+		if len(rdata) == 0 {
+			return record, false, errors.New("TXT record has no text")
+		}
+
+		var text strings.Builder
+		for _, token := range rdata {
+			text.WriteString(token.text)
+		}
+
+		record.Target = text.String()
+	case SRV:
+		if err := expect(4); err != nil {
+			return record, false, err
+		}
+
+		labels := strings.SplitN(record.Name, ".", 3)
+		if len(labels) < 2 || !strings.HasPrefix(labels[0], "_") || !strings.HasPrefix(labels[1], "_") {
+			return record, false, errors.Errorf("SRV owner %q isn't of the form _service._protocol", owner)
+		}
+
+		record.Service = labels[0][1:]
+		record.Protocol = labels[1][1:]
+		record.Name = ""
+		if len(labels) == 3 {
+			record.Name = labels[2]
+		}
+
+		var fields [3]uint64
+		for i := range fields {
+			if fields[i], err = strconv.ParseUint(rdata[i].text, 10, 16); err != nil {
+				return record, false, errors.Errorf("invalid SRV field %q", rdata[i].text)
+			}
+		}
+
+		if fields[0] > 255 {
+			return record, false, errors.Errorf("SRV priority %d is larger than Linode allows", fields[0])
+		}
+
+		record.Priority = uint8(fields[0])
+		record.Weight = uint(fields[1])
+		record.Port = uint(fields[2])
+		if record.Target, err = p.host(rdata[3].text); err != nil {
+			return record, false, err
+		}
+	case CAA:
+		if err := expect(3); err != nil {
+			return record, false, err
+		}
+
+		record.Tag = rdata[1].text
+		record.Target = rdata[2].text
+	default:
+		return record, false, errors.Errorf("unsupported record type %q", recordType)
+	}
+
+	return record, false, nil
+}
+
+// isLinodeNameserver reports whether a host is one of Linode's own nameservers.
+func isLinodeNameserver(host string) bool {
+	for _, ns := range linodeNameservers {
+		if strings.EqualFold(strings.TrimSuffix(host, "."), ns) {
+			return true
+		}
+	}
+
+	return false
+}
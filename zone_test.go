@@ -0,0 +1,164 @@
+package lingo_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/eriktate/lingo"
+)
+
+const testZoneFile = `; exported from another provider
+$ORIGIN example.com.
+$TTL 1h
+@	IN	SOA	ns1.example.net. host\.master.example.com. (
+		2024010101 ; serial
+		4h         ; refresh
+		3600       ; retry
+		2w         ; expire
+		300 )      ; minimum
+@		IN	NS	ns1.linode.com.
+		IN	NS	ns2.linode.com.
+@		IN	A	192.0.2.1
+www	300	IN	CNAME	@
+mail		IN	A	192.0.2.2
+		IN	AAAA	2001:db8::2
+@		IN	MX	10 mail
+@		IN	TXT	"v=spf1 mx " "-all"
+_sip._tcp	IN	SRV	10 20 5060 sip.example.com.
+_imap._tcp	IN	SRV	0 0 0 .
+sub		IN	NS	ns.other.org.
+@		IN	CAA	0 issue "letsencrypt.org"
+$ORIGIN hosts.example.com.
+1		IN	PTR	example.com.
+`
+
+func Test_ParseZone(t *testing.T) {
+	zone, err := lingo.ParseZone(strings.NewReader(testZoneFile), "")
+	if err != nil {
+		t.Fatalf("Failed to parse zone: %s", err)
+	}
+
+	expectedDomain := lingo.Domain{
+		Domain:     "example.com",
+		Type:       lingo.DomainTypeMaster,
+		SOA:        "host.master@example.com",
+		TTLSec:     3600,
+		RefreshSec: 14400,
+		RetrySec:   3600,
+		ExpireSec:  1209600,
+	}
+
+	if !reflect.DeepEqual(zone.Domain, expectedDomain) {
+		t.Fatalf("Expected domain %+v, but got %+v", expectedDomain, zone.Domain)
+	}
+
+	expectedRecords := []lingo.DomainRecord{
+		{Type: lingo.A, Target: "192.0.2.1"},
+		{Type: lingo.CNAME, Name: "www", Target: "example.com", TTLSec: 300},
+		{Type: lingo.A, Name: "mail", Target: "192.0.2.2"},
+		{Type: lingo.AAAA, Name: "mail", Target: "2001:db8::2"},
+		{Type: lingo.MX, Target: "mail.example.com", Priority: 10},
+		{Type: lingo.TXT, Target: "v=spf1 mx -all"},
+		{Type: lingo.SRV, Service: "sip", Protocol: "tcp", Priority: 10, Weight: 20, Port: 5060, Target: "sip.example.com"},
+		{Type: lingo.SRV, Service: "imap", Protocol: "tcp", Target: "."},
+		{Type: lingo.NS, Name: "sub", Target: "ns.other.org"},
+		{Type: lingo.CAA, Tag: "issue", Target: "letsencrypt.org"},
+		{Type: lingo.PTR, Name: "1.hosts", Target: "example.com"},
+	}
+
+	if len(zone.Records) != len(expectedRecords) {
+		t.Fatalf("Expected %d records, but got %d: %+v", len(expectedRecords), len(zone.Records), zone.Records)
+	}
+
+	for i, expected := range expectedRecords {
+		if !reflect.DeepEqual(zone.Records[i], expected) {
+			t.Fatalf("Expected record %d to be %+v, but got %+v", i, expected, zone.Records[i])
+		}
+	}
+
+	zone, err = lingo.ParseZone(strings.NewReader(`$ORIGIN example.net.
+$TTL 300
+@	IN	SOA	ns1.linode.com. admin.example.net. ( 1 7200 600 604800 300 )
+www	IN	A	192.0.2.1
+$TTL 3600
+api	IN	A	192.0.2.2
+cdn	60	IN	A	192.0.2.3
+$TTL 5m
+mail	IN	A	192.0.2.4
+`), "")
+	if err != nil {
+		t.Fatalf("Failed to parse zone with two $TTLs: %s", err)
+	}
+
+	expectedTTLs := map[string]uint{"www": 0, "api": 3600, "cdn": 60, "mail": 0}
+	if zone.Domain.TTLSec != 300 || len(zone.Records) != len(expectedTTLs) {
+		t.Fatalf("Unexpected zone with two $TTLs: %+v", zone)
+	}
+
+	for _, record := range zone.Records {
+		if record.TTLSec != expectedTTLs[record.Name] {
+			t.Fatalf("Expected %s to have TTL %d, but got %d", record.Name, expectedTTLs[record.Name], record.TTLSec)
+		}
+	}
+}
+
+func Test_ZoneRoundTrip(t *testing.T) {
+	longText := strings.Repeat("a", 300) + `with "quotes" and \backslashes`
+	zone := lingo.Zone{
+		Domain: lingo.Domain{
+			Domain:     "example.org",
+			Type:       lingo.DomainTypeMaster,
+			SOA:        "admin@example.org",
+			TTLSec:     300,
+			RefreshSec: 7200,
+			RetrySec:   600,
+			ExpireSec:  604800,
+		},
+		Records: []lingo.DomainRecord{
+			{Type: lingo.A, Name: "www", Target: "192.0.2.10", TTLSec: 30},
+			{Type: lingo.TXT, Name: "_dmarc", Target: longText},
+			{Type: lingo.MX, Target: "mx.example.net", Priority: 5},
+			{Type: lingo.SRV, Service: "xmpp", Protocol: "tcp", Name: "chat", Priority: 1, Weight: 5, Port: 5222, Target: "chat.example.org"},
+		},
+		Serial: 2024060100,
+	}
+
+	exported := zone.String()
+	if !strings.Contains(exported, "2024060100\t; serial") {
+		t.Fatalf("Expected the serial to be exported, got:\n%s", exported)
+	}
+
+	if !strings.Contains(exported, `admin.example.org.`) {
+		t.Fatalf("Expected the SOA mailbox to be exported, got:\n%s", exported)
+	}
+
+	parsed, err := lingo.ParseZone(strings.NewReader(exported), "")
+	if err != nil {
+		t.Fatalf("Failed to parse exported zone: %s\n%s", err, exported)
+	}
+
+	if !reflect.DeepEqual(parsed.Domain, zone.Domain) {
+		t.Fatalf("Expected domain %+v to survive a round trip, but got %+v", zone.Domain, parsed.Domain)
+	}
+
+	if !reflect.DeepEqual(parsed.Records, zone.Records) {
+		t.Fatalf("Expected records %+v to survive a round trip, but got %+v", zone.Records, parsed.Records)
+	}
+}
+
+func Test_ParseZoneErrors(t *testing.T) {
+	cases := map[string]string{
+		"no origin":       "www IN A 192.0.2.1\n",
+		"outside of zone": "$ORIGIN example.com.\nwww.example.org. IN A 192.0.2.1\n",
+		"unsupported":     "$ORIGIN example.com.\n@ IN HINFO \"cpu\" \"os\"\n",
+		"unbalanced":      "$ORIGIN example.com.\n@ IN SOA ns. host. ( 1 2 3 4 5\n",
+		"bad srv owner":   "$ORIGIN example.com.\nsip IN SRV 1 2 3 target.\n",
+	}
+
+	for name, file := range cases {
+		if _, err := lingo.ParseZone(strings.NewReader(file), ""); err == nil {
+			t.Fatalf("Expected %s zone to fail to parse", name)
+		}
+	}
+}